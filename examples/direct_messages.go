@@ -47,7 +47,7 @@ func main() {
 	}
 
 	// Show Direct Message event
-	event, _, err := client.DirectMessages.EventsShow("1066903366071017476", nil)
+	event, _, err := client.DirectMessages.EventsShow(1066903366071017476, nil)
 	fmt.Printf("DM Events Show:\n%+v, %v\n", event.Message.Data, err)
 
 	// Create Direct Message event
@@ -57,7 +57,7 @@ func main() {
 				Type: "message_create",
 				Message: &twitter.DirectMessageEventMessage{
 					Target: &twitter.DirectMessageTarget{
-						RecipientID: "2856535627",
+						RecipientID: 2856535627,
 					},
 					Data: &twitter.DirectMessageData{
 						Text: "testing",
@@ -69,6 +69,6 @@ func main() {
 	*/
 
 	// Destroy Direct Message event
-	//_, err = client.DirectMessages.EventsDestroy("1066904217049133060")
+	//_, err = client.DirectMessages.EventsDestroy(1066904217049133060)
 	//fmt.Printf("DM Events Delete:\n err: %v\n", err)
 }
@@ -46,11 +46,13 @@ func main() {
 
 	fmt.Println("Starting Stream...")
 
-	filterParams := &twitter.StreamParams{
-		TweetFields: []string{"created_at", "text", "id", "lang", "public_metrics", "referenced_tweets", "conversation_id", "entities"},
-		Expansions:  []string{"author_id", "referenced_tweets.id", "in_reply_to_user_id", "entities.mentions.username", "referenced_tweets.id.author_id"},
-		UserFields:  []string{"id", "name", "username", "public_metrics", "verified"},
-	}
+	fields := twitter.FieldSet{}.
+		WithTweetFields(twitter.TweetFieldCreatedAt, twitter.TweetFieldText, twitter.TweetFieldID, twitter.TweetFieldLang,
+			twitter.TweetFieldPublicMetrics, twitter.TweetFieldReferencedTweets, twitter.TweetFieldConversationID, twitter.TweetFieldEntities).
+		WithExpansions(twitter.ExpansionAuthorID, twitter.ExpansionReferencedTweetsID, twitter.ExpansionInReplyToUserID,
+			twitter.ExpansionEntitiesMentionsUsername, twitter.ExpansionReferencedTweetsIDAuthorID).
+		WithUserFields(twitter.UserFieldID, twitter.UserFieldName, twitter.UserFieldUsername, twitter.UserFieldPublicMetrics, twitter.UserFieldVerified)
+	filterParams := &twitter.StreamParams{FieldSet: fields}
 	stream, err := client.Streams.Filter(filterParams)
 	if err != nil {
 		log.Fatal(err)
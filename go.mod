@@ -1,9 +1,17 @@
 module github.com/carbonrook/go-twitter
 
-go 1.16
+go 1.18
 
 require (
 	github.com/cenkalti/backoff/v4 v4.1.2
 	github.com/dghubble/sling v1.4.0
-	github.com/stretchr/testify v1.7.0
+	github.com/google/go-querystring v1.1.0
+	github.com/stretchr/testify v1.8.2
+	golang.org/x/text v0.14.0
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
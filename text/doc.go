@@ -0,0 +1,25 @@
+/*
+Package text implements the twitter-text rules Twitter uses to count and
+validate the text of Tweets and Direct Messages, so text can be checked
+before it is posted.
+
+Twitter weighs characters rather than counting them: most Latin,
+punctuation, and symbol characters weigh 1, while others, such as CJK
+characters and emoji, weigh 2. URLs weigh 23 however long they are, since
+Twitter shortens them with t.co. A Tweet may weigh up to 280.
+
+	result := text.Parse("just setting up my twttr https://example.com/a/very/long/path")
+	if !result.Valid {
+		// shorten the text
+	}
+	fmt.Println(result.WeightedLength) // 48
+
+Truncate shortens text to a weighted length without splitting a URL or a
+user-perceived character, such as an emoji with a skin tone.
+
+	tweet := text.Truncate(long, 280, "…")
+
+The counting rules are those of twitter-text v3.
+https://developer.twitter.com/en/docs/counting-characters
+*/
+package text
@@ -0,0 +1,122 @@
+package text
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// EntityType is the type of an Entity.
+type EntityType int
+
+// Entity types.
+const (
+	HashtagEntity EntityType = iota
+	MentionEntity
+	CashtagEntity
+	URLEntity
+)
+
+// Entity is a hashtag, mention, cashtag, or URL in text.
+type Entity struct {
+	Type EntityType
+	// Range is the byte offsets of the entity in the text, including the
+	// leading # of hashtags, @ of mentions, and $ of cashtags.
+	Range
+	// Value is the hashtag, username, or cashtag without its leading
+	// symbol, or the URL.
+	Value string
+}
+
+var (
+	hashtagPattern = regexp.MustCompile(`(^|[^&\p{L}\p{M}\p{N}_])([#＃])([\p{L}\p{M}\p{N}_]*\p{L}[\p{L}\p{M}\p{N}_]*)`)
+	mentionPattern = regexp.MustCompile(`(^|[^a-zA-Z0-9_!#$%&*@＠])([@＠])([a-zA-Z0-9_]{1,15})`)
+	cashtagPattern = regexp.MustCompile(`(^|\s)(\$)([a-zA-Z]{1,6}(?:[._][a-zA-Z]{1,2})?)`)
+)
+
+// Extract returns the hashtags, mentions, cashtags, and URLs in text,
+// ordered by their position. Entities never overlap; hashtags and other
+// entities within URLs are not extracted.
+func Extract(text string) []Entity {
+	entities := ExtractURLs(text)
+	for _, extract := range []func(string) []Entity{ExtractHashtags, ExtractMentions, ExtractCashtags} {
+		for _, entity := range extract(text) {
+			if !overlaps(entities, entity) {
+				entities = append(entities, entity)
+			}
+		}
+	}
+	sort.Slice(entities, func(i, j int) bool {
+		return entities[i].Start < entities[j].Start
+	})
+	return entities
+}
+
+// ExtractHashtags returns the hashtags in text, which must contain a letter
+// (e.g. not "#1"). Hashtags within URLs are not extracted.
+func ExtractHashtags(text string) []Entity {
+	return extract(text, hashtagPattern, HashtagEntity, func(after string) bool {
+		// a hashtag may not run into another hashtag or a URL
+		return !strings.HasPrefix(after, "#") && !strings.HasPrefix(after, "＃") && !strings.HasPrefix(after, "://")
+	})
+}
+
+// ExtractMentions returns the mentions of usernames in text. Email
+// addresses are not mentions.
+func ExtractMentions(text string) []Entity {
+	return extract(text, mentionPattern, MentionEntity, func(after string) bool {
+		r, _ := utf8.DecodeRuneInString(after)
+		return r != '@' && r != '＠' && !strings.HasPrefix(after, "://") && !isWordRune(r)
+	})
+}
+
+// ExtractCashtags returns the cashtags (e.g. "$TWTR") in text.
+func ExtractCashtags(text string) []Entity {
+	return extract(text, cashtagPattern, CashtagEntity, func(after string) bool {
+		r, _ := utf8.DecodeRuneInString(after)
+		return after == "" || !isWordRune(r) && r != '$'
+	})
+}
+
+// ExtractURLs returns the URLs in text, with or without a protocol.
+func ExtractURLs(text string) []Entity {
+	var entities []Entity
+	for _, url := range urlRanges(text) {
+		entities = append(entities, Entity{
+			Type:  URLEntity,
+			Range: Range{url[0], url[1]},
+			Value: text[url[0]:url[1]],
+		})
+	}
+	return entities
+}
+
+// extract returns the entities matching pattern, whose second group is the
+// leading symbol and third group is the value, and which are followed by
+// text accepted by valid. Entities within URLs are dropped.
+func extract(text string, pattern *regexp.Regexp, typ EntityType, valid func(after string) bool) []Entity {
+	urls := ExtractURLs(text)
+	var entities []Entity
+	for _, match := range pattern.FindAllStringSubmatchIndex(text, -1) {
+		entity := Entity{
+			Type:  typ,
+			Range: Range{match[4], match[7]},
+			Value: text[match[6]:match[7]],
+		}
+		if valid(text[entity.End:]) && !overlaps(urls, entity) {
+			entities = append(entities, entity)
+		}
+	}
+	return entities
+}
+
+// overlaps returns true if entity overlaps any of the entities.
+func overlaps(entities []Entity, entity Entity) bool {
+	for _, e := range entities {
+		if e.Start < entity.End && entity.Start < e.End {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,100 @@
+package text
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	zeroWidthJoiner = '\u200d'
+	keycap          = '\u20e3'
+	// emojiPresentation is the variation selector requesting emoji style
+	emojiPresentation = '\ufe0f'
+)
+
+// graphemeEnd returns the end offset of the user-perceived character which
+// starts at offset i of s. User-perceived characters approximate Unicode
+// extended grapheme clusters: a character followed by its combining marks,
+// variation selectors, and emoji modifiers, emoji joined by zero width
+// joiners, flags made of regional indicator pairs, and CRLF.
+func graphemeEnd(s string, i int) int {
+	r, size := utf8.DecodeRuneInString(s[i:])
+	end := i + size
+	if r == '\r' && end < len(s) && s[end] == '\n' {
+		return end + 1
+	}
+	if isRegionalIndicator(r) {
+		if next, size := utf8.DecodeRuneInString(s[end:]); isRegionalIndicator(next) {
+			end += size
+		}
+	}
+	for end < len(s) {
+		next, size := utf8.DecodeRuneInString(s[end:])
+		switch {
+		case isExtend(next):
+			end += size
+		case next == zeroWidthJoiner:
+			end += size
+			// the joiner binds the following emoji into the sequence
+			if joined, size := utf8.DecodeRuneInString(s[end:]); end < len(s) && isPictographic(joined) {
+				end += size
+			}
+		case isHangulJamo(r) && isHangulJamo(next):
+			end += size
+		default:
+			return end
+		}
+	}
+	return end
+}
+
+// isEmoji returns true if the user-perceived character g is an emoji,
+// which twitter-text weighs as a single character however many code points
+// it has.
+func isEmoji(g string) bool {
+	first, _ := utf8.DecodeRuneInString(g)
+	if isRegionalIndicator(first) {
+		return len(g) > utf8.RuneLen(first)
+	}
+	for _, r := range g {
+		// text style characters, such as digits and ©, are emoji when
+		// followed by the emoji variation selector or a keycap
+		if r == emojiPresentation || r == keycap {
+			return true
+		}
+	}
+	return first >= 0x1F000 && isPictographic(first) || 0x2600 <= first && first <= 0x27BF
+}
+
+// isExtend returns true if r extends the preceding character.
+func isExtend(r rune) bool {
+	return unicode.In(r, unicode.Mn, unicode.Me, unicode.Mc) ||
+		0xFE00 <= r && r <= 0xFE0F || // variation selectors
+		0x1F3FB <= r && r <= 0x1F3FF || // emoji skin tone modifiers
+		0xE0020 <= r && r <= 0xE007F || // emoji tag sequences
+		0xE0100 <= r && r <= 0xE01EF // variation selectors supplement
+}
+
+// isRegionalIndicator returns true if r is one of the letters of the
+// regional indicator pairs which make up flag emoji.
+func isRegionalIndicator(r rune) bool {
+	return 0x1F1E6 <= r && r <= 0x1F1FF
+}
+
+// isHangulJamo returns true if r is a conjoining Hangul letter.
+func isHangulJamo(r rune) bool {
+	return 0x1100 <= r && r <= 0x11FF || 0xA960 <= r && r <= 0xA97F || 0xD7B0 <= r && r <= 0xD7FF
+}
+
+// isPictographic returns true if r is an emoji or pictographic symbol.
+func isPictographic(r rune) bool {
+	switch {
+	case r == 0x00A9, r == 0x00AE, r == 0x203C, r == 0x2049, r == 0x2122, r == 0x2139,
+		0x2194 <= r && r <= 0x21AA, 0x231A <= r && r <= 0x23FF, r == 0x24C2,
+		0x25AA <= r && r <= 0x25FE, 0x2600 <= r && r <= 0x27BF, 0x2934 <= r && r <= 0x2935,
+		0x2B05 <= r && r <= 0x2B55, r == 0x3030, r == 0x303D, r == 0x3297, r == 0x3299,
+		0x1F000 <= r && r <= 0x1FAFF:
+		return true
+	}
+	return false
+}
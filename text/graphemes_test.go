@@ -0,0 +1,55 @@
+package text
+
+import (
+	"testing"
+)
+
+func TestGraphemeEnd(t *testing.T) {
+	cases := []struct {
+		name     string
+		s        string
+		expected int
+	}{
+		{"ascii", "ab", 1},
+		{"multibyte", "我们", 3},
+		{"combining mark", "e\u0301x", 3},
+		{"crlf", "\r\nx", 2},
+		{"lone cr", "\rx", 1},
+		{"variation selector", "❤\ufe0fx", 6},
+		{"keycap", "1\ufe0f\u20e3x", 7},
+		{"skin tone", "👍🏻x", 8},
+		{"zwj sequence", "👨\u200d👩\u200d👧\u200d👦x", 25},
+		{"flag", "🇺🇸🇬🇧", 8},
+		{"lone regional indicator", "🇺x", 4},
+		{"tag sequence", "🏴\U000E0067\U000E0062\U000E0065\U000E006E\U000E0067\U000E007Fx", 28},
+		{"hangul jamo", "\u1100\u1161\u11a8x", 9},
+	}
+	for _, c := range cases {
+		if end := graphemeEnd(c.s, 0); end != c.expected {
+			t.Errorf("%s: expected %d, got %d", c.name, c.expected, end)
+		}
+	}
+}
+
+func TestIsEmoji(t *testing.T) {
+	cases := []struct {
+		g        string
+		expected bool
+	}{
+		{"a", false},
+		{"1", false},
+		{"©", false},
+		{"©\ufe0f", true},
+		{"1\ufe0f\u20e3", true},
+		{"😷", true},
+		{"❤", true},
+		{"🇺🇸", true},
+		{"🇺", false},
+		{"我", false},
+	}
+	for _, c := range cases {
+		if got := isEmoji(c.g); got != c.expected {
+			t.Errorf("isEmoji(%q): expected %t, got %t", c.g, c.expected, got)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package text
+
+// Truncate shortens text to at most maxLength weighted characters with the
+// DefaultConfig, appending the suffix (e.g. "…") if the text was shortened.
+// It never splits a URL or a user-perceived character, so it may return
+// less than maxLength.
+func Truncate(text string, maxLength int, suffix string) string {
+	return DefaultConfig.Truncate(text, maxLength, suffix)
+}
+
+// Truncate is like the package Truncate but weighs text with the Config.
+func (c Config) Truncate(text string, maxLength int, suffix string) string {
+	max := maxLength * c.Scale
+	if c.weight(text) <= max {
+		return text
+	}
+	// leave room for the suffix
+	max -= c.weight(suffix)
+	weighted := 0
+	end := 0
+	for _, unit := range c.units(text) {
+		weighted += c.unitWeight(text[unit.Start:unit.End], unit.url)
+		if weighted > max {
+			break
+		}
+		end = unit.End
+	}
+	return text[:end] + suffix
+}
+
+// weight returns the scaled weight of text.
+func (c Config) weight(text string) int {
+	weighted := 0
+	for _, unit := range c.units(text) {
+		weighted += c.unitWeight(text[unit.Start:unit.End], unit.url)
+	}
+	return weighted
+}
@@ -0,0 +1,38 @@
+package text
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTruncate(t *testing.T) {
+	cases := []struct {
+		name      string
+		text      string
+		maxLength int
+		suffix    string
+		expected  string
+	}{
+		{"fits", "hello world", 11, "…", "hello world"},
+		{"latin", "hello world", 5, "", "hello"},
+		{"suffix", "hello world", 8, "...", "hello..."},
+		{"weighted suffix", "hello world", 8, "…", "hello …"},
+		{"cjk", "我们是朋友", 5, "", "我们"},
+		{"emoji not split", "ab👍🏻", 3, "", "ab"},
+		{"zwj sequence not split", "a👨\u200d👩\u200d👧\u200d👦", 2, "", "a"},
+		{"flag not split", "a🇺🇸🇬🇧", 4, "", "a🇺🇸"},
+		{"combining mark not split", "abe\u0301", 2, "", "ab"},
+		{"url not split", "see https://example.com/path", 10, "", "see "},
+		{"url kept whole", "https://example.com/a/very/long/path and more", 23, "", "https://example.com/a/very/long/path"},
+		{"first character too heavy", "我", 1, "", ""},
+		{"max tweet", strings.Repeat("a", 300), 280, "", strings.Repeat("a", 280)},
+	}
+	for _, c := range cases {
+		if got := Truncate(c.text, c.maxLength, c.suffix); got != c.expected {
+			t.Errorf("%s: expected %q, got %q", c.name, c.expected, got)
+		}
+		if got := Truncate(c.text, c.maxLength, c.suffix); WeightedLength(got) > c.maxLength {
+			t.Errorf("%s: expected at most %d, got %d", c.name, c.maxLength, WeightedLength(got))
+		}
+	}
+}
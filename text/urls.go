@@ -0,0 +1,97 @@
+package text
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// urlPattern matches URL candidates, with or without a protocol. Candidates
+// are then checked for a known top level domain and valid surroundings.
+var urlPattern = regexp.MustCompile(`(?i)(https?://)?((?:[\p{L}\p{N}_](?:[\p{L}\p{N}_-]*[\p{L}\p{N}_])?\.)+)(\p{L}{2,})(:\d{1,5})?([/?#][^\s]*)?`)
+
+// genericTLDs are the generic top level domains URLs without a protocol are
+// recognized in.
+var genericTLDs = toSet(`aero app art asia biz blog cat club com coop dev edu email gov info int io jobs
+	live mil mobi museum name net news online org page pro shop site store tech tel travel tv xxx xyz`)
+
+// countryTLDs are the country code top level domains. URLs without a
+// protocol are only recognized in them when they have a path.
+var countryTLDs = toSet(`ac ad ae af ag ai al am ao aq ar as at au aw ax az ba bb bd be bf bg bh bi bj bm
+	bn bo br bs bt bw by bz ca cc cd cf cg ch ci ck cl cm cn co cr cu cv cw cx cy cz de dj dk dm do dz ec ee eg
+	er es et eu fi fj fk fm fo fr ga gd ge gf gg gh gi gl gm gn gp gq gr gs gt gu gw gy hk hm hn hr ht hu id ie il
+	im in iq ir is it je jm jo jp ke kg kh ki km kn kp kr kw ky kz la lb lc li lk lr ls lt lu lv ly ma mc md me mg
+	mh mk ml mm mn mo mp mq mr ms mt mu mv mw mx my mz na nc ne nf ng ni nl no np nr nu nz om pa pe pf pg ph pk pl
+	pm pn pr ps pt pw py qa re ro rs ru rw sa sb sc sd se sg sh si sk sl sm sn so sr ss st sv sx sy sz tc td tf tg
+	th tj tk tl tm tn to tr tt tw tz ua ug uk us uy uz va vc ve vg vi vn vu wf ws ye yt za zm zw`)
+
+// toSet returns the set of the whitespace separated words of s.
+func toSet(s string) map[string]bool {
+	set := make(map[string]bool)
+	for _, word := range strings.Fields(s) {
+		set[word] = true
+	}
+	return set
+}
+
+// urlRanges returns the byte offset ranges of the URLs in s.
+func urlRanges(s string) [][2]int {
+	var ranges [][2]int
+	for _, match := range urlPattern.FindAllStringSubmatchIndex(s, -1) {
+		start, end := match[0], match[1]
+		protocol := match[3] > match[2]
+		tld := strings.ToLower(s[match[6]:match[7]])
+		hasPath := match[11] > match[10]
+		if !genericTLDs[tld] && !countryTLDs[tld] {
+			continue
+		}
+		if !protocol && countryTLDs[tld] && !hasPath {
+			continue
+		}
+		// URLs don't follow letters, digits, or the @ of an email address
+		if before, _ := utf8.DecodeLastRuneInString(s[:start]); start > 0 && !validBeforeURL(before, protocol) {
+			continue
+		}
+		if !hasPath {
+			// the domain must end where the match does (e.g. not "example.com_x")
+			if after, _ := utf8.DecodeRuneInString(s[end:]); end < len(s) && (isWordRune(after) || after == '@') {
+				continue
+			}
+		}
+		ranges = append(ranges, [2]int{start, trimURL(s, start, end)})
+	}
+	return ranges
+}
+
+// validBeforeURL returns true if a URL may follow the character r.
+func validBeforeURL(r rune, protocol bool) bool {
+	if protocol {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}
+	return !isWordRune(r) && !strings.ContainsRune("@＠$#＃/.-", r)
+}
+
+// isWordRune returns true if r is a letter, digit, or underscore.
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+}
+
+// trimURL returns the end of the URL from start to end of s without
+// trailing punctuation, which ends the sentence rather than the URL, and
+// without closing brackets which were not opened in the URL.
+func trimURL(s string, start, end int) int {
+	for end > start {
+		last, size := utf8.DecodeLastRuneInString(s[start:end])
+		url := s[start:end]
+		switch {
+		case strings.ContainsRune(".,;:!?'\"", last):
+		case last == ')' && strings.Count(url, "(") < strings.Count(url, ")"):
+		case last == ']' && strings.Count(url, "[") < strings.Count(url, "]"):
+		default:
+			return end
+		}
+		end -= size
+	}
+	return end
+}
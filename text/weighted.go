@@ -0,0 +1,158 @@
+package text
+
+import (
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Range is a half-open range [Start, End) of byte offsets into text.
+type Range struct {
+	Start int
+	End   int
+}
+
+// WeightRange gives the characters from Start to End (inclusive code
+// points) a Weight other than the default.
+type WeightRange struct {
+	Start  rune
+	End    rune
+	Weight int
+}
+
+// Config is a set of twitter-text counting rules. Weights are scaled by
+// Scale, so a weight of 200 with a Scale of 100 counts as 2 characters.
+type Config struct {
+	MaxWeightedTweetLength int
+	Scale                  int
+	DefaultWeight          int
+	TransformedURLLength   int
+	Ranges                 []WeightRange
+	EmojiParsingEnabled    bool
+}
+
+// DefaultConfig is the twitter-text v3 configuration Twitter counts Tweets
+// with.
+var DefaultConfig = Config{
+	MaxWeightedTweetLength: 280,
+	Scale:                  100,
+	DefaultWeight:          200,
+	TransformedURLLength:   23,
+	Ranges: []WeightRange{
+		{Start: 0, End: 4351, Weight: 100},
+		{Start: 8192, End: 8205, Weight: 100},
+		{Start: 8208, End: 8223, Weight: 100},
+		{Start: 8242, End: 8247, Weight: 100},
+	},
+	EmojiParsingEnabled: true,
+}
+
+// Result is the result of parsing text with a Config.
+type Result struct {
+	// WeightedLength is the weighted length of the text.
+	WeightedLength int
+	// Permillage is the weighted length in thousandths of the maximum.
+	Permillage int
+	// Valid is true if the text may be posted: it is not blank, is within
+	// the maximum weighted length, and has no invalid characters.
+	Valid bool
+	// ValidRange is the longest prefix of the text within the maximum
+	// weighted length. It never splits a URL or a user-perceived character.
+	ValidRange Range
+}
+
+// Parse parses text with the DefaultConfig.
+func Parse(text string) Result {
+	return DefaultConfig.Parse(text)
+}
+
+// WeightedLength returns the weighted length of text with the
+// DefaultConfig.
+func WeightedLength(text string) int {
+	return DefaultConfig.Parse(text).WeightedLength
+}
+
+// IsValid returns true if text may be posted as a Tweet, according to the
+// DefaultConfig.
+func IsValid(text string) bool {
+	return DefaultConfig.Parse(text).Valid
+}
+
+// Parse returns the weighted length and validity of text. Text is counted
+// in Unicode Normalization Form C, as Twitter normalizes it, while the
+// ValidRange indexes the text as given.
+func (c Config) Parse(text string) Result {
+	max := c.MaxWeightedTweetLength * c.Scale
+	weighted := 0
+	valid := true
+	result := Result{}
+	for _, unit := range c.units(text) {
+		weighted += c.unitWeight(text[unit.Start:unit.End], unit.url)
+		if weighted <= max {
+			result.ValidRange.End = unit.End
+		}
+		if strings.ContainsAny(text[unit.Start:unit.End], invalidCharacters) {
+			valid = false
+		}
+	}
+	result.WeightedLength = weighted / c.Scale
+	result.Permillage = result.WeightedLength * 1000 / c.MaxWeightedTweetLength
+	result.Valid = valid && weighted <= max && strings.TrimSpace(text) != ""
+	return result
+}
+
+// invalidCharacters may not appear in a Tweet.
+const invalidCharacters = "\ufffe\ufeff\uffff\u202a\u202b\u202c\u202d\u202e"
+
+// unit is a span of text counted as a whole: a URL or a user-perceived
+// character.
+type unit struct {
+	Range
+	url bool
+}
+
+// units splits text into URLs and the user-perceived characters between
+// them.
+func (c Config) units(text string) []unit {
+	var units []unit
+	start := 0
+	characters := func(end int) {
+		for start < end {
+			next := graphemeEnd(text[:end], start)
+			units = append(units, unit{Range: Range{start, next}})
+			start = next
+		}
+	}
+	for _, url := range urlRanges(text) {
+		characters(url[0])
+		units = append(units, unit{Range: Range{url[0], url[1]}, url: true})
+		start = url[1]
+	}
+	characters(len(text))
+	return units
+}
+
+// unitWeight returns the scaled weight of a URL or user-perceived character.
+func (c Config) unitWeight(s string, url bool) int {
+	if url {
+		return c.TransformedURLLength * c.Scale
+	}
+	if c.EmojiParsingEnabled && isEmoji(s) {
+		return c.DefaultWeight
+	}
+	weight := 0
+	for _, r := range norm.NFC.String(s) {
+		weight += c.runeWeight(r)
+	}
+	return weight
+}
+
+// runeWeight returns the scaled weight of a code point.
+func (c Config) runeWeight(r rune) int {
+	for _, wr := range c.Ranges {
+		if wr.Start <= r && r <= wr.End {
+			return wr.Weight
+		}
+	}
+	return c.DefaultWeight
+}
@@ -0,0 +1,78 @@
+package text
+
+import (
+	"strings"
+	"testing"
+)
+
+// Cases from the twitter-text v3 WeightedTweetsCounterTest conformance suite.
+func TestParse(t *testing.T) {
+	cases := []struct {
+		name       string
+		text       string
+		weighted   int
+		permillage int
+		valid      bool
+		validRange Range
+	}{
+		{"empty", "", 0, 0, false, Range{0, 0}},
+		{"blank", "   ", 3, 10, false, Range{0, 3}},
+		{"latin", "Hello world", 11, 39, true, Range{0, 11}},
+		{"max latin", strings.Repeat("a", 280), 280, 1000, true, Range{0, 280}},
+		{"over max latin", strings.Repeat("a", 281), 281, 1003, false, Range{0, 280}},
+		{"cjk", "我们", 4, 14, true, Range{0, 6}},
+		{"max cjk", strings.Repeat("我", 140), 280, 1000, true, Range{0, 420}},
+		{"over max cjk", strings.Repeat("我", 141), 282, 1007, false, Range{0, 420}},
+		{"url", "https://example.com/a/very/long/path/which/counts/as/twenty/three", 23, 82, true, Range{0, 65}},
+		{"text and url", "just setting up my twttr https://example.com/a/very/long/path", 48, 171, true, Range{0, 61}},
+		{"url without protocol", "see example.com", 27, 96, true, Range{0, 15}},
+		{"emoji", "😷", 2, 7, true, Range{0, 4}},
+		{"emoji with skin tone", "👍🏻", 2, 7, true, Range{0, 8}},
+		{"emoji zwj sequence", "👨\u200d👩\u200d👧\u200d👦", 2, 7, true, Range{0, 25}},
+		{"flag", "🇺🇸", 2, 7, true, Range{0, 8}},
+		{"keycap", "1\ufe0f\u20e3", 2, 7, true, Range{0, 7}},
+		{"combining mark normalized", "e\u0301", 1, 3, true, Range{0, 3}},
+		{"general punctuation", "‘quoted’", 8, 28, true, Range{0, 12}},
+		{"ellipsis", "…", 2, 7, true, Range{0, 3}},
+		{"invalid character", "a\ufffeb", 4, 14, false, Range{0, 5}},
+		{"directional override", "a\u202eb", 4, 14, false, Range{0, 5}},
+	}
+	for _, c := range cases {
+		result := Parse(c.text)
+		if result.WeightedLength != c.weighted {
+			t.Errorf("%s: expected WeightedLength %d, got %d", c.name, c.weighted, result.WeightedLength)
+		}
+		if result.Permillage != c.permillage {
+			t.Errorf("%s: expected Permillage %d, got %d", c.name, c.permillage, result.Permillage)
+		}
+		if result.Valid != c.valid {
+			t.Errorf("%s: expected Valid %t, got %t", c.name, c.valid, result.Valid)
+		}
+		if result.ValidRange != c.validRange {
+			t.Errorf("%s: expected ValidRange %v, got %v", c.name, c.validRange, result.ValidRange)
+		}
+		if WeightedLength(c.text) != c.weighted {
+			t.Errorf("%s: expected WeightedLength %d, got %d", c.name, c.weighted, WeightedLength(c.text))
+		}
+		if IsValid(c.text) != c.valid {
+			t.Errorf("%s: expected IsValid %t, got %t", c.name, c.valid, IsValid(c.text))
+		}
+	}
+}
+
+func TestConfigParse(t *testing.T) {
+	// twitter-text v1 counted every code point as 1 and a Tweet as 140
+	v1 := Config{
+		MaxWeightedTweetLength: 140,
+		Scale:                  1,
+		DefaultWeight:          1,
+		TransformedURLLength:   23,
+	}
+	result := v1.Parse(strings.Repeat("我", 140))
+	if result.WeightedLength != 140 || !result.Valid {
+		t.Errorf("expected 140 valid, got %d %t", result.WeightedLength, result.Valid)
+	}
+	if result := v1.Parse("😷"); result.WeightedLength != 1 {
+		t.Errorf("expected emoji to weigh 1, got %d", result.WeightedLength)
+	}
+}
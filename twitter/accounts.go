@@ -1,6 +1,7 @@
 package twitter
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/dghubble/sling"
@@ -30,8 +31,13 @@ type AccountVerifyParams struct {
 // Requires a user auth context.
 // https://dev.twitter.com/rest/reference/get/account/verify_credentials
 func (s *AccountService) VerifyCredentials(params *AccountVerifyParams) (*User, *http.Response, error) {
+	return s.VerifyCredentialsWithContext(context.Background(), params)
+}
+
+// VerifyCredentialsWithContext is like VerifyCredentials but uses the given context for the request.
+func (s *AccountService) VerifyCredentialsWithContext(ctx context.Context, params *AccountVerifyParams) (*User, *http.Response, error) {
 	user := new(User)
 	apiError := new(APIError)
-	resp, err := s.sling.New().Get("verify_credentials.json").QueryStruct(params).Receive(user, apiError)
-	return user, resp, relevantError(err, *apiError)
+	resp, err := receive(ctx, s.sling.New().Get("verify_credentials.json").QueryStruct(params), user, apiError)
+	return user, resp, relevantError(resp, err, *apiError)
 }
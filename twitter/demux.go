@@ -24,6 +24,9 @@ type SwitchDemux struct {
 	FriendsList      func(friendsList *FriendsList)
 	Event            func(event *Event)
 	StreamData       func(data *StreamData)
+	StreamError      func(streamError *StreamError)
+	KeepAlive        func(keepAlive *StreamKeepAlive)
+	DecodeError      func(decodeError *StreamDecodeError)
 	Other            func(message interface{})
 }
 
@@ -43,6 +46,9 @@ func NewSwitchDemux() SwitchDemux {
 		FriendsList:      func(friendsList *FriendsList) {},
 		Event:            func(event *Event) {},
 		StreamData:       func(data *StreamData) {},
+		StreamError:      func(streamError *StreamError) {},
+		KeepAlive:        func(keepAlive *StreamKeepAlive) {},
+		DecodeError:      func(decodeError *StreamDecodeError) {},
 		Other:            func(message interface{}) {},
 	}
 }
@@ -77,6 +83,12 @@ func (d SwitchDemux) Handle(message interface{}) {
 		d.Event(msg)
 	case *StreamData:
 		d.StreamData(msg)
+	case *StreamError:
+		d.StreamError(msg)
+	case *StreamKeepAlive:
+		d.KeepAlive(msg)
+	case *StreamDecodeError:
+		d.DecodeError(msg)
 	default:
 		d.Other(msg)
 	}
@@ -1,6 +1,8 @@
 package twitter
 
 import (
+	"context"
+	"errors"
 	"net/http"
 	"time"
 
@@ -13,10 +15,11 @@ type DirectMessageEvents struct {
 	NextCursor string               `json:"next_cursor"`
 }
 
-// DirectMessageEvent is a single Direct Message sent or received.
+// DirectMessageEvent is a single Direct Message sent or received. CreatedAt
+// is nil for events being sent, so it is omitted from EventsNew requests.
 type DirectMessageEvent struct {
-	CreatedAt string                     `json:"created_timestamp,omitempty"`
-	ID        string                     `json:"id,omitempty"`
+	CreatedAt *Timestamp                 `json:"created_timestamp,omitempty"`
+	ID        DirectMessageID            `json:"id,omitempty"`
 	Type      string                     `json:"type"`
 	Message   *DirectMessageEventMessage `json:"message_create"`
 }
@@ -24,14 +27,14 @@ type DirectMessageEvent struct {
 // DirectMessageEventMessage contains message contents, along with sender and
 // target recipient.
 type DirectMessageEventMessage struct {
-	SenderID string               `json:"sender_id,omitempty"`
+	SenderID UserID               `json:"sender_id,omitempty"`
 	Target   *DirectMessageTarget `json:"target"`
 	Data     *DirectMessageData   `json:"message_data"`
 }
 
 // DirectMessageTarget specifies the recipient of a Direct Message event.
 type DirectMessageTarget struct {
-	RecipientID string `json:"recipient_id"`
+	RecipientID UserID `json:"recipient_id"`
 }
 
 // DirectMessageData is the message data contained in a Direct Message event.
@@ -97,25 +100,35 @@ type DirectMessageEventsNewParams struct {
 // Requires a user auth context with DM scope.
 // https://developer.twitter.com/en/docs/direct-messages/sending-and-receiving/api-reference/new-event
 func (s *DirectMessageService) EventsNew(params *DirectMessageEventsNewParams) (*DirectMessageEvent, *http.Response, error) {
+	return s.EventsNewWithContext(context.Background(), params)
+}
+
+// EventsNewWithContext is like EventsNew but uses the given context for the request.
+func (s *DirectMessageService) EventsNewWithContext(ctx context.Context, params *DirectMessageEventsNewParams) (*DirectMessageEvent, *http.Response, error) {
 	// Twitter API wraps the event response
 	wrap := &struct {
 		Event *DirectMessageEvent `json:"event"`
 	}{}
 	apiError := new(APIError)
-	resp, err := s.sling.New().Post("events/new.json").BodyJSON(params).Receive(wrap, apiError)
-	return wrap.Event, resp, relevantError(err, *apiError)
+	resp, err := receive(ctx, s.sling.New().Post("events/new.json").BodyJSON(params), wrap, apiError)
+	return wrap.Event, resp, relevantError(resp, err, *apiError)
 }
 
 // DirectMessageEventsShowParams are the parameters for
 // DirectMessageService.EventsShow
 type DirectMessageEventsShowParams struct {
-	ID string `url:"id,omitempty"`
+	ID DirectMessageID `url:"id,omitempty"`
 }
 
 // EventsShow returns a single Direct Message event by id.
 // Requires a user auth context with DM scope.
 // https://developer.twitter.com/en/docs/direct-messages/sending-and-receiving/api-reference/get-event
-func (s *DirectMessageService) EventsShow(id string, params *DirectMessageEventsShowParams) (*DirectMessageEvent, *http.Response, error) {
+func (s *DirectMessageService) EventsShow(id DirectMessageID, params *DirectMessageEventsShowParams) (*DirectMessageEvent, *http.Response, error) {
+	return s.EventsShowWithContext(context.Background(), id, params)
+}
+
+// EventsShowWithContext is like EventsShow but uses the given context for the request.
+func (s *DirectMessageService) EventsShowWithContext(ctx context.Context, id DirectMessageID, params *DirectMessageEventsShowParams) (*DirectMessageEvent, *http.Response, error) {
 	if params == nil {
 		params = &DirectMessageEventsShowParams{}
 	}
@@ -125,8 +138,8 @@ func (s *DirectMessageService) EventsShow(id string, params *DirectMessageEvents
 		Event *DirectMessageEvent `json:"event"`
 	}{}
 	apiError := new(APIError)
-	resp, err := s.sling.New().Get("events/show.json").QueryStruct(params).Receive(wrap, apiError)
-	return wrap.Event, resp, relevantError(err, *apiError)
+	resp, err := receive(ctx, s.sling.New().Get("events/show.json").QueryStruct(params), wrap, apiError)
+	return wrap.Event, resp, relevantError(resp, err, *apiError)
 }
 
 // DirectMessageEventsListParams are the parameters for
@@ -141,70 +154,104 @@ type DirectMessageEventsListParams struct {
 // Requires a user auth context with DM scope.
 // https://developer.twitter.com/en/docs/direct-messages/sending-and-receiving/api-reference/list-events
 func (s *DirectMessageService) EventsList(params *DirectMessageEventsListParams) (*DirectMessageEvents, *http.Response, error) {
+	return s.EventsListWithContext(context.Background(), params)
+}
+
+// EventsListWithContext is like EventsList but uses the given context for the request.
+func (s *DirectMessageService) EventsListWithContext(ctx context.Context, params *DirectMessageEventsListParams) (*DirectMessageEvents, *http.Response, error) {
 	events := new(DirectMessageEvents)
 	apiError := new(APIError)
-	resp, err := s.sling.New().Get("events/list.json").QueryStruct(params).Receive(events, apiError)
-	return events, resp, relevantError(err, *apiError)
+	resp, err := receive(ctx, s.sling.New().Get("events/list.json").QueryStruct(params), events, apiError)
+	return events, resp, relevantError(resp, err, *apiError)
+}
+
+// EventsListPaginator returns a Paginator over the Direct Message events
+// returned by EventsList, fetching pages as needed.
+func (s *DirectMessageService) EventsListPaginator(ctx context.Context, params *DirectMessageEventsListParams) *Paginator[DirectMessageEvent] {
+	p := DirectMessageEventsListParams{}
+	if params != nil {
+		p = *params
+	}
+	return NewTokenPaginator(ctx, func(ctx context.Context, cursor string) ([]DirectMessageEvent, string, *http.Response, error) {
+		p.Cursor = cursor
+		page, resp, err := s.EventsListWithContext(ctx, &p)
+		return page.Events, page.NextCursor, resp, err
+	})
 }
 
 // EventsDestroy deletes the Direct Message event by id.
 // Requires a user auth context with DM scope.
 // https://developer.twitter.com/en/docs/direct-messages/sending-and-receiving/api-reference/delete-message-event
-func (s *DirectMessageService) EventsDestroy(id string) (*http.Response, error) {
+func (s *DirectMessageService) EventsDestroy(id DirectMessageID) (*http.Response, error) {
+	return s.EventsDestroyWithContext(context.Background(), id)
+}
+
+// EventsDestroyWithContext is like EventsDestroy but uses the given context for the request.
+func (s *DirectMessageService) EventsDestroyWithContext(ctx context.Context, id DirectMessageID) (*http.Response, error) {
 	params := struct {
-		ID string `url:"id,omitempty"`
+		ID DirectMessageID `url:"id,omitempty"`
 	}{id}
 	apiError := new(APIError)
-	resp, err := s.sling.New().Delete("events/destroy.json").QueryStruct(params).Receive(nil, apiError)
-	return resp, relevantError(err, *apiError)
+	resp, err := receive(ctx, s.sling.New().Delete("events/destroy.json").QueryStruct(params), nil, apiError)
+	return resp, relevantError(resp, err, *apiError)
 }
 
 // DEPRECATED
 
 // DirectMessage is a direct message to a single recipient (DEPRECATED).
 type DirectMessage struct {
-	CreatedAt           string    `json:"created_at"`
-	Entities            *Entities `json:"entities"`
-	ID                  int64     `json:"id"`
-	IDStr               string    `json:"id_str"`
-	Recipient           *User     `json:"recipient"`
-	RecipientID         int64     `json:"recipient_id"`
-	RecipientScreenName string    `json:"recipient_screen_name"`
-	Sender              *User     `json:"sender"`
-	SenderID            int64     `json:"sender_id"`
-	SenderScreenName    string    `json:"sender_screen_name"`
-	Text                string    `json:"text"`
+	CreatedAt           Timestamp       `json:"created_at"`
+	Entities            *Entities       `json:"entities"`
+	ID                  DirectMessageID `json:"id"`
+	IDStr               string          `json:"id_str"`
+	Recipient           *User           `json:"recipient"`
+	RecipientID         UserID          `json:"recipient_id"`
+	RecipientScreenName string          `json:"recipient_screen_name"`
+	Sender              *User           `json:"sender"`
+	SenderID            UserID          `json:"sender_id"`
+	SenderScreenName    string          `json:"sender_screen_name"`
+	Text                string          `json:"text"`
 }
 
 // CreatedAtTime returns the time a Direct Message was created (DEPRECATED).
+//
+// Deprecated: use CreatedAt, which is decoded as a Timestamp.
 func (d DirectMessage) CreatedAtTime() (time.Time, error) {
-	return time.Parse(time.RubyDate, d.CreatedAt)
+	if d.CreatedAt.IsZero() {
+		return time.Time{}, errors.New("twitter: direct message has no created_at")
+	}
+	return d.CreatedAt.Time, nil
 }
 
 // directMessageShowParams are the parameters for DirectMessageService.Show
 type directMessageShowParams struct {
-	ID int64 `url:"id,omitempty"`
+	ID DirectMessageID `url:"id,omitempty"`
 }
 
 // Show returns the requested Direct Message (DEPRECATED).
 // Requires a user auth context with DM scope.
 // https://dev.twitter.com/rest/reference/get/direct_messages/show
-func (s *DirectMessageService) Show(id int64) (*DirectMessage, *http.Response, error) {
+func (s *DirectMessageService) Show(id DirectMessageID) (*DirectMessage, *http.Response, error) {
+	return s.ShowWithContext(context.Background(), id)
+}
+
+// ShowWithContext is like Show but uses the given context for the request.
+func (s *DirectMessageService) ShowWithContext(ctx context.Context, id DirectMessageID) (*DirectMessage, *http.Response, error) {
 	params := &directMessageShowParams{ID: id}
 	dm := new(DirectMessage)
 	apiError := new(APIError)
-	resp, err := s.sling.New().Get("show.json").QueryStruct(params).Receive(dm, apiError)
-	return dm, resp, relevantError(err, *apiError)
+	resp, err := receive(ctx, s.sling.New().Get("show.json").QueryStruct(params), dm, apiError)
+	return dm, resp, relevantError(resp, err, *apiError)
 }
 
 // DirectMessageGetParams are the parameters for DirectMessageService.Get
 // (DEPRECATED).
 type DirectMessageGetParams struct {
-	SinceID         int64 `url:"since_id,omitempty"`
-	MaxID           int64 `url:"max_id,omitempty"`
-	Count           int   `url:"count,omitempty"`
-	IncludeEntities *bool `url:"include_entities,omitempty"`
-	SkipStatus      *bool `url:"skip_status,omitempty"`
+	SinceID         DirectMessageID `url:"since_id,omitempty"`
+	MaxID           DirectMessageID `url:"max_id,omitempty"`
+	Count           int             `url:"count,omitempty"`
+	IncludeEntities *bool           `url:"include_entities,omitempty"`
+	SkipStatus      *bool           `url:"skip_status,omitempty"`
 }
 
 // Get returns recent Direct Messages received by the authenticated user
@@ -212,20 +259,25 @@ type DirectMessageGetParams struct {
 // Requires a user auth context with DM scope.
 // https://dev.twitter.com/rest/reference/get/direct_messages
 func (s *DirectMessageService) Get(params *DirectMessageGetParams) ([]DirectMessage, *http.Response, error) {
+	return s.GetWithContext(context.Background(), params)
+}
+
+// GetWithContext is like Get but uses the given context for the request.
+func (s *DirectMessageService) GetWithContext(ctx context.Context, params *DirectMessageGetParams) ([]DirectMessage, *http.Response, error) {
 	dms := new([]DirectMessage)
 	apiError := new(APIError)
-	resp, err := s.baseSling.New().Get("direct_messages.json").QueryStruct(params).Receive(dms, apiError)
-	return *dms, resp, relevantError(err, *apiError)
+	resp, err := receive(ctx, s.baseSling.New().Get("direct_messages.json").QueryStruct(params), dms, apiError)
+	return *dms, resp, relevantError(resp, err, *apiError)
 }
 
 // DirectMessageSentParams are the parameters for DirectMessageService.Sent
 // (DEPRECATED).
 type DirectMessageSentParams struct {
-	SinceID         int64 `url:"since_id,omitempty"`
-	MaxID           int64 `url:"max_id,omitempty"`
-	Count           int   `url:"count,omitempty"`
-	Page            int   `url:"page,omitempty"`
-	IncludeEntities *bool `url:"include_entities,omitempty"`
+	SinceID         DirectMessageID `url:"since_id,omitempty"`
+	MaxID           DirectMessageID `url:"max_id,omitempty"`
+	Count           int             `url:"count,omitempty"`
+	Page            int             `url:"page,omitempty"`
+	IncludeEntities *bool           `url:"include_entities,omitempty"`
 }
 
 // Sent returns recent Direct Messages sent by the authenticated user
@@ -233,16 +285,21 @@ type DirectMessageSentParams struct {
 // Requires a user auth context with DM scope.
 // https://dev.twitter.com/rest/reference/get/direct_messages/sent
 func (s *DirectMessageService) Sent(params *DirectMessageSentParams) ([]DirectMessage, *http.Response, error) {
+	return s.SentWithContext(context.Background(), params)
+}
+
+// SentWithContext is like Sent but uses the given context for the request.
+func (s *DirectMessageService) SentWithContext(ctx context.Context, params *DirectMessageSentParams) ([]DirectMessage, *http.Response, error) {
 	dms := new([]DirectMessage)
 	apiError := new(APIError)
-	resp, err := s.sling.New().Get("sent.json").QueryStruct(params).Receive(dms, apiError)
-	return *dms, resp, relevantError(err, *apiError)
+	resp, err := receive(ctx, s.sling.New().Get("sent.json").QueryStruct(params), dms, apiError)
+	return *dms, resp, relevantError(resp, err, *apiError)
 }
 
 // DirectMessageNewParams are the parameters for DirectMessageService.New
 // (DEPRECATED).
 type DirectMessageNewParams struct {
-	UserID     int64  `url:"user_id,omitempty"`
+	UserID     UserID `url:"user_id,omitempty"`
 	ScreenName string `url:"screen_name,omitempty"`
 	Text       string `url:"text"`
 }
@@ -252,30 +309,40 @@ type DirectMessageNewParams struct {
 // Requires a user auth context with DM scope.
 // https://dev.twitter.com/rest/reference/post/direct_messages/new
 func (s *DirectMessageService) New(params *DirectMessageNewParams) (*DirectMessage, *http.Response, error) {
+	return s.NewWithContext(context.Background(), params)
+}
+
+// NewWithContext is like New but uses the given context for the request.
+func (s *DirectMessageService) NewWithContext(ctx context.Context, params *DirectMessageNewParams) (*DirectMessage, *http.Response, error) {
 	dm := new(DirectMessage)
 	apiError := new(APIError)
-	resp, err := s.sling.New().Post("new.json").BodyForm(params).Receive(dm, apiError)
-	return dm, resp, relevantError(err, *apiError)
+	resp, err := receive(ctx, s.sling.New().Post("new.json").BodyForm(params), dm, apiError)
+	return dm, resp, relevantError(resp, err, *apiError)
 }
 
 // DirectMessageDestroyParams are the parameters for DirectMessageService.Destroy
 // (DEPRECATED).
 type DirectMessageDestroyParams struct {
-	ID              int64 `url:"id,omitempty"`
-	IncludeEntities *bool `url:"include_entities,omitempty"`
+	ID              DirectMessageID `url:"id,omitempty"`
+	IncludeEntities *bool           `url:"include_entities,omitempty"`
 }
 
 // Destroy deletes the Direct Message with the given id and returns it if
 // successful (DEPRECATED).
 // Requires a user auth context with DM scope.
 // https://dev.twitter.com/rest/reference/post/direct_messages/destroy
-func (s *DirectMessageService) Destroy(id int64, params *DirectMessageDestroyParams) (*DirectMessage, *http.Response, error) {
+func (s *DirectMessageService) Destroy(id DirectMessageID, params *DirectMessageDestroyParams) (*DirectMessage, *http.Response, error) {
+	return s.DestroyWithContext(context.Background(), id, params)
+}
+
+// DestroyWithContext is like Destroy but uses the given context for the request.
+func (s *DirectMessageService) DestroyWithContext(ctx context.Context, id DirectMessageID, params *DirectMessageDestroyParams) (*DirectMessage, *http.Response, error) {
 	if params == nil {
 		params = &DirectMessageDestroyParams{}
 	}
 	params.ID = id
 	dm := new(DirectMessage)
 	apiError := new(APIError)
-	resp, err := s.sling.New().Post("destroy.json").BodyForm(params).Receive(dm, apiError)
-	return dm, resp, relevantError(err, *apiError)
+	resp, err := receive(ctx, s.sling.New().Post("destroy.json").BodyForm(params), dm, apiError)
+	return dm, resp, relevantError(resp, err, *apiError)
 }
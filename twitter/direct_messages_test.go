@@ -0,0 +1,44 @@
+package twitter
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDirectMessageService_EventsNew(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/2/direct_messages/events/new.json", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		body, _ := ioutil.ReadAll(r.Body)
+		// the event being sent has no id or created_timestamp
+		assert.JSONEq(t, `{"event": {"type": "message_create", "message_create": {
+			"target": {"recipient_id": "2244994945"}, "message_data": {"text": "hi"}}}}`, string(body))
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"event": {"type": "message_create", "id": "1000", "created_timestamp": "1520000000000", "message_create": {
+			"sender_id": "6253282", "target": {"recipient_id": "2244994945"}, "message_data": {"text": "hi"}}}}`)
+	})
+
+	client := NewClient(httpClient)
+	event, _, err := client.DirectMessages.EventsNew(&DirectMessageEventsNewParams{
+		Event: &DirectMessageEvent{
+			Type: "message_create",
+			Message: &DirectMessageEventMessage{
+				Target: &DirectMessageTarget{RecipientID: 2244994945},
+				Data:   &DirectMessageData{Text: "hi"},
+			},
+		},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, DirectMessageID(1000), event.ID)
+	if assert.NotNil(t, event.CreatedAt) {
+		assert.True(t, event.CreatedAt.Equal(time.UnixMilli(1520000000000)))
+	}
+	assert.Equal(t, UserID(6253282), event.Message.SenderID)
+}
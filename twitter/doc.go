@@ -11,8 +11,8 @@ some example requests.
 	tweets, resp, err := client.Timelines.HomeTimeline(&HomeTimelineParams{})
 	// Send a Tweet
 	tweet, resp, err := client.Statuses.Update("just setting up my twttr", nil)
-	// Status Show
-	tweet, resp, err := client.Statuses.Show(585613041028431872, nil)
+	// Tweet Lookup
+	tweets, resp, err := client.Tweets.Lookup([]twitter.TweetID{585613041028431872}, nil)
 	// User Show
 	params := &twitter.UserShowParams{ScreenName: "dghubble"}
 	user, resp, err := client.Users.Show(params)
@@ -22,6 +22,13 @@ some example requests.
 Required parameters are passed as positional arguments. Optional parameters
 are passed in a typed params struct (or pass nil).
 
+Every method has a WithContext variant which takes a context.Context as its
+first argument, so requests can be cancelled or given a deadline.
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	tweets, resp, err := client.Tweets.LookupWithContext(ctx, []twitter.TweetID{585613041028431872}, nil)
+
 Authentication
 
 By design, the Twitter Client accepts any http.Client so user auth (OAuth1) or
@@ -69,6 +76,20 @@ application auth.
 	// Twitter client
 	client := twitter.NewClient(httpClient)
 
+Alternately, authorize requests as your application with an OAuth2 bearer
+token using the WithBearerToken Option.
+
+	client := twitter.NewClient(http.DefaultClient, twitter.WithBearerToken("token"))
+
+Other Options set the API base URL (e.g. for a local stand-in server or a
+proxy), the User-Agent, or headers sent with every request.
+
+	client := twitter.NewClient(httpClient,
+		twitter.WithBaseURL("http://localhost:8080/2/"),
+		twitter.WithUserAgent("my-app v1.0"),
+		twitter.WithHeader("X-Request-Source", "my-app"),
+	)
+
 To implement Login with Twitter, see https://github.com/dghubble/gologin.
 
 */
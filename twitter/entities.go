@@ -1,5 +1,9 @@
 package twitter
 
+import (
+	"encoding/json"
+)
+
 // Entities represent metadata and context info parsed from Twitter components.
 // https://developer.twitter.com/en/docs/tweets/data-dictionary/overview/entities-object
 // TODO: symbols
@@ -11,6 +15,40 @@ type Entities struct {
 	Cashtags     []TagEntity         `json:"cashtags"`
 }
 
+// UnmarshalJSON decodes Entities from v1.1 or v2 JSON. v2 keys user
+// mentions and cashtags as "mentions" and "cashtags", v1.1 as
+// "user_mentions" and "symbols".
+func (e *Entities) UnmarshalJSON(data []byte) error {
+	type entities Entities
+	var decoded struct {
+		entities
+		Mentions []MentionEntity `json:"mentions"`
+		Symbols  []TagEntity     `json:"symbols"`
+	}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	*e = Entities(decoded.entities)
+	if e.UserMentions == nil {
+		e.UserMentions = decoded.Mentions
+	}
+	if e.Cashtags == nil {
+		e.Cashtags = decoded.Symbols
+	}
+	return nil
+}
+
+// indices are the [start, end) offsets of a v1.1 entity, which v2 gives as
+// separate start and end fields.
+type indices []int64
+
+// set sets start and end from the indices, if there are any.
+func (i indices) set(start, end *int64) {
+	if len(i) == 2 {
+		*start, *end = i[0], i[1]
+	}
+}
+
 // TagEntity represents a hashtag or cashtag from the text.
 type TagEntity struct {
 	Start int64  `json:"start"`
@@ -18,6 +56,26 @@ type TagEntity struct {
 	Tag   string `json:"tag"`
 }
 
+// UnmarshalJSON decodes a TagEntity from v2 JSON, or from v1.1 JSON which
+// gives the tag as "text" and the offsets as "indices".
+func (t *TagEntity) UnmarshalJSON(data []byte) error {
+	type tag TagEntity
+	var decoded struct {
+		tag
+		Text    string  `json:"text"`
+		Indices indices `json:"indices"`
+	}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	*t = TagEntity(decoded.tag)
+	if t.Tag == "" {
+		t.Tag = decoded.Text
+	}
+	decoded.Indices.set(&t.Start, &t.End)
+	return nil
+}
+
 // AnnotationEntity represents one of Twitter's context annotations.
 type AnnotationEntity struct {
 	Start          int64  `json:"start"`
@@ -37,19 +95,43 @@ type URLEntity struct {
 	UnwoundURL  string `json:"unwound_url"`
 }
 
+// UnmarshalJSON decodes a URLEntity from v2 JSON, or from v1.1 JSON which
+// gives the offsets as "indices".
+func (u *URLEntity) UnmarshalJSON(data []byte) error {
+	type urlEntity URLEntity
+	var decoded struct {
+		urlEntity
+		Indices indices `json:"indices"`
+	}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	*u = URLEntity(decoded.urlEntity)
+	decoded.Indices.set(&u.Start, &u.End)
+	return nil
+}
+
 // MediaEntity represents media elements associated with a Tweet.
 type MediaEntity struct {
-	MediaKey         string       `json:"media_key"`
-	DurationMillis   int64        `json:"duration_ms"`
-	Height           int64        `json:"height"`
-	NonPublicMetrics MediaMetrics `json:"non_public_metrics"`
-	OrganicMetrics   MediaMetrics `json:"organic_metrics"`
-	Type             string       `json:"type"`
-	PreviewImageURL  string       `json:"preview_image_url"`
-	PromotedMetrics  MediaMetrics `json:"promoted_metrics"`
-	PublicMetrics    MediaMetrics `json:"public_metrics"`
-	Width            int64        `json:"width"`
-	AltText          string       `json:"alt_text"`
+	MediaKey         string         `json:"media_key"`
+	DurationMillis   int64          `json:"duration_ms"`
+	Height           int64          `json:"height"`
+	NonPublicMetrics MediaMetrics   `json:"non_public_metrics"`
+	OrganicMetrics   MediaMetrics   `json:"organic_metrics"`
+	Type             string         `json:"type"`
+	PreviewImageURL  string         `json:"preview_image_url"`
+	PromotedMetrics  MediaMetrics   `json:"promoted_metrics"`
+	PublicMetrics    MediaMetrics   `json:"public_metrics"`
+	Width            int64          `json:"width"`
+	AltText          string         `json:"alt_text"`
+	Variants         []MediaVariant `json:"variants"`
+}
+
+// MediaVariant is an encoding of a video or animated GIF.
+type MediaVariant struct {
+	BitRate     int64  `json:"bit_rate"`
+	ContentType string `json:"content_type"`
+	URL         string `json:"url"`
 }
 
 type MediaMetrics struct {
@@ -68,6 +150,26 @@ type MentionEntity struct {
 	Username string `json:"username"`
 }
 
+// UnmarshalJSON decodes a MentionEntity from v2 JSON, or from v1.1 JSON
+// which gives the username as "screen_name" and the offsets as "indices".
+func (m *MentionEntity) UnmarshalJSON(data []byte) error {
+	type mention MentionEntity
+	var decoded struct {
+		mention
+		ScreenName string  `json:"screen_name"`
+		Indices    indices `json:"indices"`
+	}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	*m = MentionEntity(decoded.mention)
+	if m.Username == "" {
+		m.Username = decoded.ScreenName
+	}
+	decoded.Indices.set(&m.Start, &m.End)
+	return nil
+}
+
 // UserEntities contain Entities parsed from User url and description fields.
 // https://developer.twitter.com/en/docs/tweets/data-dictionary/overview/entities-object#mentions
 type UserEntities struct {
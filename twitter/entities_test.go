@@ -0,0 +1,72 @@
+package twitter
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEntities_UnmarshalJSONv1(t *testing.T) {
+	data := `{
+		"hashtags": [{"indices": [23, 30], "text": "nodejs"}],
+		"symbols": [{"indices": [31, 36], "text": "TWTR"}],
+		"user_mentions": [{
+			"screen_name": "TwitterDev",
+			"name": "Twitter Dev",
+			"id": 2244994945,
+			"id_str": "2244994945",
+			"indices": [4, 15]
+		}],
+		"urls": [{
+			"url": "https://t.co/abc",
+			"expanded_url": "https://nodejs.org",
+			"display_url": "nodejs.org",
+			"indices": [37, 53]
+		}]
+	}`
+	var entities Entities
+	assert.Nil(t, json.Unmarshal([]byte(data), &entities))
+	assert.Equal(t, Entities{
+		Hashtags:     []TagEntity{{Start: 23, End: 30, Tag: "nodejs"}},
+		Cashtags:     []TagEntity{{Start: 31, End: 36, Tag: "TWTR"}},
+		UserMentions: []MentionEntity{{Start: 4, End: 15, Username: "TwitterDev"}},
+		Urls: []URLEntity{{
+			Start:       37,
+			End:         53,
+			URL:         "https://t.co/abc",
+			ExpandedURL: "https://nodejs.org",
+			DisplayURL:  "nodejs.org",
+		}},
+	}, entities)
+
+	r := Renderer{Offsets: UTF16Offsets}
+	assert.Equal(t,
+		`Hey <a href="https://twitter.com/TwitterDev">@TwitterDev</a>, check <a href="https://twitter.com/hashtag/nodejs">#nodejs</a> `+
+			`<a href="https://twitter.com/search?q=%24TWTR">$TWTR</a> <a href="https://nodejs.org">nodejs.org</a>`,
+		r.HTML("Hey @TwitterDev, check #nodejs $TWTR https://t.co/abc", &entities))
+}
+
+func TestEntities_UnmarshalJSONv2(t *testing.T) {
+	data := `{
+		"hashtags": [{"start": 23, "end": 30, "tag": "nodejs"}],
+		"cashtags": [{"start": 31, "end": 36, "tag": "TWTR"}],
+		"mentions": [{"start": 4, "end": 15, "username": "TwitterDev", "id": "2244994945"}],
+		"urls": [{"start": 37, "end": 53, "url": "https://t.co/abc", "unwound_url": "https://nodejs.org/en"}]
+	}`
+	var entities Entities
+	assert.Nil(t, json.Unmarshal([]byte(data), &entities))
+	assert.Equal(t, Entities{
+		Hashtags:     []TagEntity{{Start: 23, End: 30, Tag: "nodejs"}},
+		Cashtags:     []TagEntity{{Start: 31, End: 36, Tag: "TWTR"}},
+		UserMentions: []MentionEntity{{Start: 4, End: 15, Username: "TwitterDev"}},
+		Urls:         []URLEntity{{Start: 37, End: 53, URL: "https://t.co/abc", UnwoundURL: "https://nodejs.org/en"}},
+	}, entities)
+
+	// Entities encode in the v2 form, so they decode as they were
+	data2, err := json.Marshal(entities)
+	assert.Nil(t, err)
+	var decoded Entities
+	assert.Nil(t, json.Unmarshal(data2, &decoded))
+	assert.Equal(t, entities, decoded)
+}
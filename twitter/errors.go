@@ -1,42 +1,187 @@
 package twitter
 
 import (
+	"errors"
 	"fmt"
+	"net/http"
+	"strings"
 )
 
-// APIError represents a Twitter API Error response
+// APIError represents a Twitter API Error response. It carries the HTTP
+// status and headers of the response along with the v1.1 style errors array
+// or the v2 problem details, whichever Twitter returned.
 // https://dev.twitter.com/overview/api/response-codes
+// https://developer.twitter.com/en/support/twitter-api/error-troubleshooting
 type APIError struct {
+	// StatusCode and Header are set from the HTTP response.
+	StatusCode int         `json:"-"`
+	Header     http.Header `json:"-"`
+	// RateLimit is the rate limit state reported by the response, if any.
+	RateLimit *Rate `json:"-"`
+	// Errors lists v1.1 errors or the v2 partial errors returned alongside
+	// (or instead of) data.
 	Errors []ErrorDetail `json:"errors"`
+	// v2 problem details
+	Type   string `json:"type"`
+	Title  string `json:"title"`
+	Detail string `json:"detail"`
+	Status int    `json:"status"`
 }
 
-// ErrorDetail represents an individual item in an APIError.
+// ErrorDetail represents an individual item in an APIError. v1.1 errors set
+// the Message and Code, v2 errors set the remaining problem fields.
 type ErrorDetail struct {
-	Message string `json:"message"`
-	Code    int    `json:"code"`
+	Message      string `json:"message"`
+	Code         int    `json:"code"`
+	Type         string `json:"type,omitempty"`
+	Title        string `json:"title,omitempty"`
+	Detail       string `json:"detail,omitempty"`
+	Parameter    string `json:"parameter,omitempty"`
+	ResourceType string `json:"resource_type,omitempty"`
+	ResourceID   string `json:"resource_id,omitempty"`
+	Section      string `json:"section,omitempty"`
+	// DisconnectType is set by the errors of a v2 stream disconnect.
+	DisconnectType string `json:"disconnect_type,omitempty"`
 }
 
 func (e APIError) Error() string {
 	if len(e.Errors) > 0 {
 		err := e.Errors[0]
-		return fmt.Sprintf("twitter: %d %v", err.Code, err.Message)
+		if err.Message != "" {
+			return fmt.Sprintf("twitter: %d %v", err.Code, err.Message)
+		}
+		return fmt.Sprintf("twitter: %v: %v", err.Title, err.Detail)
+	}
+	if e.Title != "" {
+		return fmt.Sprintf("twitter: %d %v: %v", e.StatusCode, e.Title, e.Detail)
+	}
+	if e.StatusCode != 0 {
+		return fmt.Sprintf("twitter: %d %v", e.StatusCode, http.StatusText(e.StatusCode))
 	}
 	return ""
 }
 
-// Empty returns true if empty. Otherwise, at least 1 error message/code is
-// present and false is returned.
+// Empty returns true if empty. Otherwise, at least 1 error message/code or
+// problem detail is present and false is returned.
 func (e APIError) Empty() bool {
-	if len(e.Errors) == 0 {
+	return len(e.Errors) == 0 && e.Type == "" && e.Title == "" && e.Detail == ""
+}
+
+// RequestID returns the transaction ID Twitter assigned to the request, which
+// Twitter support may ask for.
+func (e APIError) RequestID() string {
+	return e.Header.Get("x-transaction-id")
+}
+
+// hasCode returns true if any v1.1 error has one of the given codes.
+func (e APIError) hasCode(codes ...int) bool {
+	for _, err := range e.Errors {
+		for _, code := range codes {
+			if err.Code == code {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// hasProblem returns true if the v2 problem or any v2 partial error has the
+// given problem type suffix (e.g. "resource-not-found").
+func (e APIError) hasProblem(problem string) bool {
+	if strings.HasSuffix(e.Type, problem) {
 		return true
 	}
+	for _, err := range e.Errors {
+		if strings.HasSuffix(err.Type, problem) {
+			return true
+		}
+	}
 	return false
 }
 
-// relevantError returns any non-nil http-related error (creating the request,
-// getting the response, decoding) if any. If the decoded apiError is non-zero
-// the apiError is returned. Otherwise, no errors occurred, returns nil.
-func relevantError(httpError error, apiError APIError) error {
+// IsRateLimited returns true if err is an APIError indicating the rate limit
+// for the request was exceeded.
+func IsRateLimited(err error) bool {
+	var apiError APIError
+	if !errors.As(err, &apiError) {
+		return false
+	}
+	return apiError.StatusCode == http.StatusTooManyRequests || apiError.hasCode(88) ||
+		apiError.hasProblem("usage-capped")
+}
+
+// IsNotFound returns true if err is an APIError indicating the requested
+// resource does not exist.
+func IsNotFound(err error) bool {
+	var apiError APIError
+	if !errors.As(err, &apiError) {
+		return false
+	}
+	return apiError.StatusCode == http.StatusNotFound || apiError.hasCode(34, 50, 144) ||
+		apiError.hasProblem("resource-not-found")
+}
+
+// IsAuthError returns true if err is an APIError indicating the request was
+// not authenticated or not authorized for the resource: a 401 response, or a
+// 403 response whose errors reject the credentials or their access level
+// (e.g. an app not enrolled for v2 access or an unsupported auth type).
+func IsAuthError(err error) bool {
+	var apiError APIError
+	if !errors.As(err, &apiError) {
+		return false
+	}
+	return apiError.StatusCode == http.StatusUnauthorized || apiError.hasCode(32, 87, 89, 99, 135, 215, 220, 261, 453) ||
+		apiError.hasProblem("not-authorized-for-resource") || apiError.hasProblem("client-forbidden") ||
+		apiError.hasProblem("client-not-enrolled") || apiError.hasProblem("unsupported-authentication")
+}
+
+// IsSuspended returns true if err is an APIError indicating the requested
+// user or the authenticated account is suspended.
+func IsSuspended(err error) bool {
+	var apiError APIError
+	if !errors.As(err, &apiError) {
+		return false
+	}
+	if apiError.hasCode(63, 64) {
+		return true
+	}
+	for _, err := range apiError.Errors {
+		if strings.HasSuffix(err.Type, "resource-unavailable") && strings.Contains(err.Detail, "suspended") {
+			return true
+		}
+	}
+	return strings.HasSuffix(apiError.Type, "resource-unavailable") && strings.Contains(apiError.Detail, "suspended")
+}
+
+// partialError returns an APIError with the errors Twitter returned
+// alongside data in a 2XX response, or nil if there were none.
+func partialError(resp *http.Response, errs []ErrorDetail) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	apiError := APIError{Errors: errs}
+	if resp != nil {
+		apiError.StatusCode = resp.StatusCode
+		apiError.Header = resp.Header
+	}
+	return apiError
+}
+
+// relevantError returns an APIError with the response status and headers if
+// the response status is not 2XX, even if the error body was empty or could
+// not be decoded. Otherwise, returns any non-nil http-related error (creating
+// the request, getting the response, decoding) if any. If the decoded
+// apiError is non-zero the apiError is returned. Otherwise, no errors
+// occurred, returns nil.
+func relevantError(resp *http.Response, httpError error, apiError APIError) error {
+	if resp != nil && (resp.StatusCode < 200 || resp.StatusCode > 299) {
+		apiError.StatusCode = resp.StatusCode
+		apiError.Header = resp.Header
+		if rate, ok := parseRate(resp.Header); ok {
+			apiError.RateLimit = &rate
+		}
+		return apiError
+	}
 	if httpError != nil {
 		return httpError
 	}
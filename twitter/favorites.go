@@ -1,6 +1,7 @@
 package twitter
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/dghubble/sling"
@@ -23,50 +24,81 @@ func newFavoriteService(sling *sling.Sling) *FavoriteService {
 
 // FavoriteListParams are the parameters for FavoriteService.List.
 type FavoriteListParams struct {
-	UserID          int64  `url:"user_id,omitempty"`
-	ScreenName      string `url:"screen_name,omitempty"`
-	Count           int    `url:"count,omitempty"`
-	SinceID         int64  `url:"since_id,omitempty"`
-	MaxID           int64  `url:"max_id,omitempty"`
-	IncludeEntities *bool  `url:"include_entities,omitempty"`
-	TweetMode       string `url:"tweet_mode,omitempty"`
+	UserID          UserID  `url:"user_id,omitempty"`
+	ScreenName      string  `url:"screen_name,omitempty"`
+	Count           int     `url:"count,omitempty"`
+	SinceID         TweetID `url:"since_id,omitempty"`
+	MaxID           TweetID `url:"max_id,omitempty"`
+	IncludeEntities *bool   `url:"include_entities,omitempty"`
+	TweetMode       string  `url:"tweet_mode,omitempty"`
 }
 
 // List returns liked Tweets from the specified user.
 // https://dev.twitter.com/rest/reference/get/favorites/list
 func (s *FavoriteService) List(params *FavoriteListParams) ([]Tweet, *http.Response, error) {
+	return s.ListWithContext(context.Background(), params)
+}
+
+// ListWithContext is like List but uses the given context for the request.
+func (s *FavoriteService) ListWithContext(ctx context.Context, params *FavoriteListParams) ([]Tweet, *http.Response, error) {
 	favorites := new([]Tweet)
 	apiError := new(APIError)
-	resp, err := s.sling.New().Get("list.json").QueryStruct(params).Receive(favorites, apiError)
-	return *favorites, resp, relevantError(err, *apiError)
+	resp, err := receive(ctx, s.sling.New().Get("list.json").QueryStruct(params), favorites, apiError)
+	return *favorites, resp, relevantError(resp, err, *apiError)
+}
+
+// ListPaginator returns a Paginator over the liked Tweets returned by
+// List, fetching older pages with max_id as needed.
+func (s *FavoriteService) ListPaginator(ctx context.Context, params *FavoriteListParams) *Paginator[Tweet] {
+	p := FavoriteListParams{}
+	if params != nil {
+		p = *params
+	}
+	return NewMaxIDPaginator(ctx, func(ctx context.Context, maxID TweetID) ([]Tweet, *http.Response, error) {
+		if maxID != 0 {
+			p.MaxID = maxID
+		}
+		page, resp, err := s.ListWithContext(ctx, &p)
+		return page, resp, err
+	}, tweetID)
 }
 
 // FavoriteCreateParams are the parameters for FavoriteService.Create.
 type FavoriteCreateParams struct {
-	ID int64 `url:"id,omitempty"`
+	ID TweetID `url:"id,omitempty"`
 }
 
 // Create favorites the specified tweet.
 // Requires a user auth context.
 // https://dev.twitter.com/rest/reference/post/favorites/create
 func (s *FavoriteService) Create(params *FavoriteCreateParams) (*Tweet, *http.Response, error) {
+	return s.CreateWithContext(context.Background(), params)
+}
+
+// CreateWithContext is like Create but uses the given context for the request.
+func (s *FavoriteService) CreateWithContext(ctx context.Context, params *FavoriteCreateParams) (*Tweet, *http.Response, error) {
 	tweet := new(Tweet)
 	apiError := new(APIError)
-	resp, err := s.sling.New().Post("create.json").QueryStruct(params).Receive(tweet, apiError)
-	return tweet, resp, relevantError(err, *apiError)
+	resp, err := receive(ctx, s.sling.New().Post("create.json").QueryStruct(params), tweet, apiError)
+	return tweet, resp, relevantError(resp, err, *apiError)
 }
 
 // FavoriteDestroyParams are the parameters for FavoriteService.Destroy.
 type FavoriteDestroyParams struct {
-	ID int64 `url:"id,omitempty"`
+	ID TweetID `url:"id,omitempty"`
 }
 
 // Destroy un-favorites the specified tweet.
 // Requires a user auth context.
 // https://dev.twitter.com/rest/reference/post/favorites/destroy
 func (s *FavoriteService) Destroy(params *FavoriteDestroyParams) (*Tweet, *http.Response, error) {
+	return s.DestroyWithContext(context.Background(), params)
+}
+
+// DestroyWithContext is like Destroy but uses the given context for the request.
+func (s *FavoriteService) DestroyWithContext(ctx context.Context, params *FavoriteDestroyParams) (*Tweet, *http.Response, error) {
 	tweet := new(Tweet)
 	apiError := new(APIError)
-	resp, err := s.sling.New().Post("destroy.json").QueryStruct(params).Receive(tweet, apiError)
-	return tweet, resp, relevantError(err, *apiError)
+	resp, err := receive(ctx, s.sling.New().Post("destroy.json").QueryStruct(params), tweet, apiError)
+	return tweet, resp, relevantError(resp, err, *apiError)
 }
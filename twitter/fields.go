@@ -0,0 +1,337 @@
+package twitter
+
+import (
+	"fmt"
+)
+
+// Expansion is a v2 expansion, which includes the objects a response
+// references (e.g. the author of a Tweet) in its Includes. Tweet and User
+// endpoints accept different expansions.
+// https://developer.twitter.com/en/docs/twitter-api/expansions
+type Expansion string
+
+// Tweet expansions, accepted by Tweet endpoints (lookup, search, timelines
+// and streams).
+const (
+	ExpansionAttachmentsPollIDs         Expansion = "attachments.poll_ids"
+	ExpansionAttachmentsMediaKeys       Expansion = "attachments.media_keys"
+	ExpansionAuthorID                   Expansion = "author_id"
+	ExpansionEditHistoryTweetIDs        Expansion = "edit_history_tweet_ids"
+	ExpansionEntitiesMentionsUsername   Expansion = "entities.mentions.username"
+	ExpansionGeoPlaceID                 Expansion = "geo.place_id"
+	ExpansionInReplyToUserID            Expansion = "in_reply_to_user_id"
+	ExpansionReferencedTweetsID         Expansion = "referenced_tweets.id"
+	ExpansionReferencedTweetsIDAuthorID Expansion = "referenced_tweets.id.author_id"
+)
+
+// User expansions, accepted by User endpoints.
+const (
+	ExpansionPinnedTweetID Expansion = "pinned_tweet_id"
+)
+
+// TweetField is a v2 Tweet field, requested with tweet.fields.
+// https://developer.twitter.com/en/docs/twitter-api/data-dictionary/object-model/tweet
+type TweetField string
+
+// Tweet fields.
+const (
+	TweetFieldAttachments         TweetField = "attachments"
+	TweetFieldAuthorID            TweetField = "author_id"
+	TweetFieldContextAnnotations  TweetField = "context_annotations"
+	TweetFieldConversationID      TweetField = "conversation_id"
+	TweetFieldCreatedAt           TweetField = "created_at"
+	TweetFieldEditControls        TweetField = "edit_controls"
+	TweetFieldEditHistoryTweetIDs TweetField = "edit_history_tweet_ids"
+	TweetFieldEntities            TweetField = "entities"
+	TweetFieldGeo                 TweetField = "geo"
+	TweetFieldID                  TweetField = "id"
+	TweetFieldInReplyToUserID     TweetField = "in_reply_to_user_id"
+	TweetFieldLang                TweetField = "lang"
+	TweetFieldNonPublicMetrics    TweetField = "non_public_metrics"
+	TweetFieldNoteTweet           TweetField = "note_tweet"
+	TweetFieldOrganicMetrics      TweetField = "organic_metrics"
+	TweetFieldPossiblySensitive   TweetField = "possibly_sensitive"
+	TweetFieldPromotedMetrics     TweetField = "promoted_metrics"
+	TweetFieldPublicMetrics       TweetField = "public_metrics"
+	TweetFieldReferencedTweets    TweetField = "referenced_tweets"
+	TweetFieldReplySettings       TweetField = "reply_settings"
+	TweetFieldSource              TweetField = "source"
+	TweetFieldText                TweetField = "text"
+	TweetFieldWithheld            TweetField = "withheld"
+)
+
+// UserField is a v2 User field, requested with user.fields.
+// https://developer.twitter.com/en/docs/twitter-api/data-dictionary/object-model/user
+type UserField string
+
+// User fields.
+const (
+	UserFieldCreatedAt         UserField = "created_at"
+	UserFieldDescription       UserField = "description"
+	UserFieldEntities          UserField = "entities"
+	UserFieldID                UserField = "id"
+	UserFieldLocation          UserField = "location"
+	UserFieldMostRecentTweetID UserField = "most_recent_tweet_id"
+	UserFieldName              UserField = "name"
+	UserFieldPinnedTweetID     UserField = "pinned_tweet_id"
+	UserFieldProfileImageURL   UserField = "profile_image_url"
+	UserFieldProtected         UserField = "protected"
+	UserFieldPublicMetrics     UserField = "public_metrics"
+	UserFieldURL               UserField = "url"
+	UserFieldUsername          UserField = "username"
+	UserFieldVerified          UserField = "verified"
+	UserFieldVerifiedType      UserField = "verified_type"
+	UserFieldWithheld          UserField = "withheld"
+)
+
+// MediaField is a v2 media field, requested with media.fields.
+// https://developer.twitter.com/en/docs/twitter-api/data-dictionary/object-model/media
+type MediaField string
+
+// Media fields.
+const (
+	MediaFieldAltText          MediaField = "alt_text"
+	MediaFieldDurationMS       MediaField = "duration_ms"
+	MediaFieldHeight           MediaField = "height"
+	MediaFieldMediaKey         MediaField = "media_key"
+	MediaFieldNonPublicMetrics MediaField = "non_public_metrics"
+	MediaFieldOrganicMetrics   MediaField = "organic_metrics"
+	MediaFieldPreviewImageURL  MediaField = "preview_image_url"
+	MediaFieldPromotedMetrics  MediaField = "promoted_metrics"
+	MediaFieldPublicMetrics    MediaField = "public_metrics"
+	MediaFieldType             MediaField = "type"
+	MediaFieldURL              MediaField = "url"
+	MediaFieldVariants         MediaField = "variants"
+	MediaFieldWidth            MediaField = "width"
+)
+
+// PlaceField is a v2 place field, requested with place.fields.
+// https://developer.twitter.com/en/docs/twitter-api/data-dictionary/object-model/place
+type PlaceField string
+
+// Place fields.
+const (
+	PlaceFieldContainedWithin PlaceField = "contained_within"
+	PlaceFieldCountry         PlaceField = "country"
+	PlaceFieldCountryCode     PlaceField = "country_code"
+	PlaceFieldFullName        PlaceField = "full_name"
+	PlaceFieldGeo             PlaceField = "geo"
+	PlaceFieldID              PlaceField = "id"
+	PlaceFieldName            PlaceField = "name"
+	PlaceFieldPlaceType       PlaceField = "place_type"
+)
+
+// PollField is a v2 poll field, requested with poll.fields.
+// https://developer.twitter.com/en/docs/twitter-api/data-dictionary/object-model/poll
+type PollField string
+
+// Poll fields.
+const (
+	PollFieldDurationMinutes PollField = "duration_minutes"
+	PollFieldEndDatetime     PollField = "end_datetime"
+	PollFieldID              PollField = "id"
+	PollFieldOptions         PollField = "options"
+	PollFieldVotingStatus    PollField = "voting_status"
+)
+
+var (
+	// tweetExpansions are the expansions of Tweet endpoints
+	tweetExpansions = []Expansion{
+		ExpansionAttachmentsMediaKeys, ExpansionAttachmentsPollIDs, ExpansionAuthorID,
+		ExpansionEditHistoryTweetIDs, ExpansionEntitiesMentionsUsername, ExpansionGeoPlaceID,
+		ExpansionInReplyToUserID, ExpansionReferencedTweetsID, ExpansionReferencedTweetsIDAuthorID,
+	}
+	// userExpansions are the expansions of User endpoints
+	userExpansions = []Expansion{ExpansionPinnedTweetID}
+	// the fields below are sorted, so requests for them are the same each
+	// time (e.g. for caching)
+	publicTweetFields = []TweetField{
+		TweetFieldAttachments, TweetFieldAuthorID, TweetFieldContextAnnotations, TweetFieldConversationID,
+		TweetFieldCreatedAt, TweetFieldEditControls, TweetFieldEditHistoryTweetIDs, TweetFieldEntities,
+		TweetFieldGeo, TweetFieldID, TweetFieldInReplyToUserID, TweetFieldLang, TweetFieldNoteTweet,
+		TweetFieldPossiblySensitive, TweetFieldPublicMetrics, TweetFieldReferencedTweets,
+		TweetFieldReplySettings, TweetFieldSource, TweetFieldText, TweetFieldWithheld,
+	}
+	publicUserFields = []UserField{
+		UserFieldCreatedAt, UserFieldDescription, UserFieldEntities, UserFieldID, UserFieldLocation,
+		UserFieldMostRecentTweetID, UserFieldName, UserFieldPinnedTweetID, UserFieldProfileImageURL,
+		UserFieldProtected, UserFieldPublicMetrics, UserFieldURL, UserFieldUsername, UserFieldVerified,
+		UserFieldVerifiedType, UserFieldWithheld,
+	}
+	publicMediaFields = []MediaField{
+		MediaFieldAltText, MediaFieldDurationMS, MediaFieldHeight, MediaFieldMediaKey,
+		MediaFieldPreviewImageURL, MediaFieldPublicMetrics, MediaFieldType, MediaFieldURL,
+		MediaFieldVariants, MediaFieldWidth,
+	}
+	publicPlaceFields = []PlaceField{
+		PlaceFieldContainedWithin, PlaceFieldCountry, PlaceFieldCountryCode, PlaceFieldFullName,
+		PlaceFieldGeo, PlaceFieldID, PlaceFieldName, PlaceFieldPlaceType,
+	}
+	publicPollFields = []PollField{
+		PollFieldDurationMinutes, PollFieldEndDatetime, PollFieldID, PollFieldOptions, PollFieldVotingStatus,
+	}
+)
+
+// FieldSet selects the expansions and fields of a v2 response. It is
+// embedded in the params of v2 endpoints, and may be built once and shared.
+//
+//	fields := twitter.FieldSet{}.
+//		WithExpansions(twitter.ExpansionAuthorID).
+//		WithTweetFields(twitter.TweetFieldCreatedAt, twitter.TweetFieldPublicMetrics).
+//		WithUserFields(twitter.UserFieldUsername)
+//	params := &twitter.TweetLookupParams{FieldSet: fields}
+type FieldSet struct {
+	Expansions  []Expansion  `url:"expansions,omitempty,comma"`
+	MediaFields []MediaField `url:"media.fields,omitempty,comma"`
+	PlaceFields []PlaceField `url:"place.fields,omitempty,comma"`
+	PollFields  []PollField  `url:"poll.fields,omitempty,comma"`
+	TweetFields []TweetField `url:"tweet.fields,omitempty,comma"`
+	UserFields  []UserField  `url:"user.fields,omitempty,comma"`
+}
+
+// TweetEverythingPublic returns a FieldSet for Tweet endpoints with every
+// Tweet expansion and every field available without a user auth context,
+// i.e. all but the non-public, organic and promoted metrics.
+func TweetEverythingPublic() FieldSet {
+	return FieldSet{
+		Expansions:  append([]Expansion{}, tweetExpansions...),
+		MediaFields: append([]MediaField{}, publicMediaFields...),
+		PlaceFields: append([]PlaceField{}, publicPlaceFields...),
+		PollFields:  append([]PollField{}, publicPollFields...),
+		TweetFields: append([]TweetField{}, publicTweetFields...),
+		UserFields:  append([]UserField{}, publicUserFields...),
+	}
+}
+
+// UserEverythingPublic returns a FieldSet for User endpoints with the
+// pinned Tweet expansion and every User and Tweet field available without a
+// user auth context.
+func UserEverythingPublic() FieldSet {
+	return FieldSet{
+		Expansions:  append([]Expansion{}, userExpansions...),
+		TweetFields: append([]TweetField{}, publicTweetFields...),
+		UserFields:  append([]UserField{}, publicUserFields...),
+	}
+}
+
+// WithExpansions returns a copy of the FieldSet with the expansions added.
+func (f FieldSet) WithExpansions(expansions ...Expansion) FieldSet {
+	f.Expansions = append(append([]Expansion{}, f.Expansions...), expansions...)
+	return f
+}
+
+// WithTweetFields returns a copy of the FieldSet with the Tweet fields added.
+func (f FieldSet) WithTweetFields(fields ...TweetField) FieldSet {
+	f.TweetFields = append(append([]TweetField{}, f.TweetFields...), fields...)
+	return f
+}
+
+// WithUserFields returns a copy of the FieldSet with the User fields added.
+func (f FieldSet) WithUserFields(fields ...UserField) FieldSet {
+	f.UserFields = append(append([]UserField{}, f.UserFields...), fields...)
+	return f
+}
+
+// WithMediaFields returns a copy of the FieldSet with the media fields added.
+func (f FieldSet) WithMediaFields(fields ...MediaField) FieldSet {
+	f.MediaFields = append(append([]MediaField{}, f.MediaFields...), fields...)
+	return f
+}
+
+// WithPlaceFields returns a copy of the FieldSet with the place fields added.
+func (f FieldSet) WithPlaceFields(fields ...PlaceField) FieldSet {
+	f.PlaceFields = append(append([]PlaceField{}, f.PlaceFields...), fields...)
+	return f
+}
+
+// WithPollFields returns a copy of the FieldSet with the poll fields added.
+func (f FieldSet) WithPollFields(fields ...PollField) FieldSet {
+	f.PollFields = append(append([]PollField{}, f.PollFields...), fields...)
+	return f
+}
+
+// ValidateForTweets returns an error if Twitter would reject the FieldSet
+// on a Tweet endpoint (lookup, search, timelines and streams) because it
+// contains an expansion other than a Tweet expansion, or a malformed field.
+// Fields are not checked against the fields this package knows, so fields
+// Twitter has added since may be requested.
+func (f FieldSet) ValidateForTweets() error {
+	if err := validateExpansions(f.Expansions, tweetExpansions, "Tweet"); err != nil {
+		return err
+	}
+	return f.validateFields()
+}
+
+// ValidateForUsers returns an error if Twitter would reject the FieldSet on
+// a User endpoint because it contains an expansion other than the pinned
+// Tweet, media, place or poll fields, or a malformed field.
+func (f FieldSet) ValidateForUsers() error {
+	if err := validateExpansions(f.Expansions, userExpansions, "User"); err != nil {
+		return err
+	}
+	switch {
+	case len(f.MediaFields) > 0:
+		return fmt.Errorf("twitter: media fields are not available from User endpoints")
+	case len(f.PlaceFields) > 0:
+		return fmt.Errorf("twitter: place fields are not available from User endpoints")
+	case len(f.PollFields) > 0:
+		return fmt.Errorf("twitter: poll fields are not available from User endpoints")
+	}
+	return f.validateFields()
+}
+
+// validateExpansions returns an error for the first expansion which is not
+// one of the allowed expansions of the kind of endpoint.
+func validateExpansions(expansions, allowed []Expansion, endpoint string) error {
+	for _, expansion := range expansions {
+		ok := false
+		for _, a := range allowed {
+			ok = ok || expansion == a
+		}
+		if !ok {
+			return fmt.Errorf("twitter: expansion %q is not available from %s endpoints", expansion, endpoint)
+		}
+	}
+	return nil
+}
+
+// validateFields returns an error for the first malformed field.
+func (f FieldSet) validateFields() error {
+	for _, err := range []error{
+		validateFieldNames("tweet", f.TweetFields),
+		validateFieldNames("user", f.UserFields),
+		validateFieldNames("media", f.MediaFields),
+		validateFieldNames("place", f.PlaceFields),
+		validateFieldNames("poll", f.PollFields),
+	} {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateFieldNames returns an error for the first malformed field of the
+// object.
+func validateFieldNames[T ~string](object string, fields []T) error {
+	for _, field := range fields {
+		if !validFieldName(string(field)) {
+			return fmt.Errorf("twitter: malformed %s field %q", object, field)
+		}
+	}
+	return nil
+}
+
+// validFieldName returns true if name looks like a field name: lowercase
+// words joined by underscores (e.g. "public_metrics").
+func validFieldName(name string) bool {
+	if name == "" || name[0] == '_' || name[len(name)-1] == '_' {
+		return false
+	}
+	for _, r := range name {
+		if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '_' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,82 @@
+package twitter
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTweetEverythingPublic(t *testing.T) {
+	fields := TweetEverythingPublic()
+	assert.Nil(t, fields.ValidateForTweets())
+	assert.NotContains(t, fields.Expansions, ExpansionPinnedTweetID)
+	assert.Contains(t, fields.TweetFields, TweetFieldEditHistoryTweetIDs)
+	assert.NotContains(t, fields.TweetFields, TweetFieldNonPublicMetrics)
+	assert.Contains(t, fields.MediaFields, MediaFieldVariants)
+	assert.NotContains(t, fields.MediaFields, MediaFieldPromotedMetrics)
+	// the FieldSets returned don't share their slices
+	fields.Expansions[0] = "changed"
+	assert.Equal(t, ExpansionAttachmentsMediaKeys, TweetEverythingPublic().Expansions[0])
+}
+
+func TestUserEverythingPublic(t *testing.T) {
+	fields := UserEverythingPublic()
+	assert.Nil(t, fields.ValidateForUsers())
+	assert.Equal(t, []Expansion{ExpansionPinnedTweetID}, fields.Expansions)
+	assert.Contains(t, fields.UserFields, UserFieldMostRecentTweetID)
+	assert.Empty(t, fields.MediaFields)
+	assert.Error(t, fields.ValidateForTweets())
+	assert.Error(t, TweetEverythingPublic().ValidateForUsers())
+}
+
+func TestFieldSet_Validate(t *testing.T) {
+	cases := []struct {
+		fields FieldSet
+		tweets bool
+		users  bool
+	}{
+		{FieldSet{}, true, true},
+		{FieldSet{}.WithExpansions(ExpansionAuthorID), true, false},
+		{FieldSet{}.WithExpansions(ExpansionPinnedTweetID), false, true},
+		{FieldSet{}.WithExpansions("author"), false, false},
+		{FieldSet{}.WithTweetFields(TweetFieldPublicMetrics).WithUserFields(UserFieldUsername), true, true},
+		// fields added to the API since are allowed
+		{FieldSet{}.WithTweetFields("some_new_field"), true, true},
+		{FieldSet{}.WithTweetFields(""), false, false},
+		{FieldSet{}.WithTweetFields("created_at,lang"), false, false},
+		{FieldSet{}.WithUserFields("Username"), false, false},
+		{FieldSet{}.WithPollFields("voting status"), false, false},
+		{FieldSet{}.WithMediaFields(MediaFieldURL), true, false},
+		{FieldSet{}.WithPlaceFields(PlaceFieldGeo), true, false},
+		{FieldSet{}.WithPollFields(PollFieldOptions), true, false},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.tweets, c.fields.ValidateForTweets() == nil, "%+v for Tweets", c.fields)
+		assert.Equal(t, c.users, c.fields.ValidateForUsers() == nil, "%+v for Users", c.fields)
+	}
+}
+
+func TestUserService_UserByID(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/2/users/12", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assert.Equal(t, "pinned_tweet_id", r.URL.Query().Get("expansions"))
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"data": {"id": "12", "username": "jack", "most_recent_tweet_id": "20"}}`)
+	})
+
+	client := NewClient(httpClient)
+	user, _, err := client.Users.UserByID(12, &UserServiceParams{UserEverythingPublic()})
+	assert.Nil(t, err)
+	assert.Equal(t, &User{ID: 12, Username: "jack", MostRecentTweetID: 20}, user)
+
+	// Tweet expansions are rejected without a request
+	user, resp, err := client.Users.UserByID(12, &UserServiceParams{TweetEverythingPublic()})
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+	assert.Equal(t, &User{}, user)
+}
@@ -1,6 +1,7 @@
 package twitter
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/dghubble/sling"
@@ -8,11 +9,11 @@ import (
 
 // FollowerIDs is a cursored collection of follower ids.
 type FollowerIDs struct {
-	IDs               []int64 `json:"ids"`
-	NextCursor        int64   `json:"next_cursor"`
-	NextCursorStr     string  `json:"next_cursor_str"`
-	PreviousCursor    int64   `json:"previous_cursor"`
-	PreviousCursorStr string  `json:"previous_cursor_str"`
+	IDs               []UserID `json:"ids"`
+	NextCursor        int64    `json:"next_cursor"`
+	NextCursorStr     string   `json:"next_cursor_str"`
+	PreviousCursor    int64    `json:"previous_cursor"`
+	PreviousCursorStr string   `json:"previous_cursor_str"`
 }
 
 // Followers is a cursored collection of followers.
@@ -38,7 +39,7 @@ func newFollowerService(sling *sling.Sling) *FollowerService {
 
 // FollowerIDParams are the parameters for FollowerService.Ids
 type FollowerIDParams struct {
-	UserID     int64  `url:"user_id,omitempty"`
+	UserID     UserID `url:"user_id,omitempty"`
 	ScreenName string `url:"screen_name,omitempty"`
 	Cursor     int64  `url:"cursor,omitempty"`
 	Count      int    `url:"count,omitempty"`
@@ -47,15 +48,34 @@ type FollowerIDParams struct {
 // IDs returns a cursored collection of user ids following the specified user.
 // https://dev.twitter.com/rest/reference/get/followers/ids
 func (s *FollowerService) IDs(params *FollowerIDParams) (*FollowerIDs, *http.Response, error) {
+	return s.IDsWithContext(context.Background(), params)
+}
+
+// IDsWithContext is like IDs but uses the given context for the request.
+func (s *FollowerService) IDsWithContext(ctx context.Context, params *FollowerIDParams) (*FollowerIDs, *http.Response, error) {
 	ids := new(FollowerIDs)
 	apiError := new(APIError)
-	resp, err := s.sling.New().Get("ids.json").QueryStruct(params).Receive(ids, apiError)
-	return ids, resp, relevantError(err, *apiError)
+	resp, err := receive(ctx, s.sling.New().Get("ids.json").QueryStruct(params), ids, apiError)
+	return ids, resp, relevantError(resp, err, *apiError)
+}
+
+// IDsPaginator returns a Paginator over the follower ids returned by
+// IDs, fetching pages as needed.
+func (s *FollowerService) IDsPaginator(ctx context.Context, params *FollowerIDParams) *Paginator[UserID] {
+	p := FollowerIDParams{}
+	if params != nil {
+		p = *params
+	}
+	return NewCursorPaginator(ctx, func(ctx context.Context, cursor int64) ([]UserID, int64, *http.Response, error) {
+		p.Cursor = cursor
+		page, resp, err := s.IDsWithContext(ctx, &p)
+		return page.IDs, page.NextCursor, resp, err
+	})
 }
 
 // FollowerListParams are the parameters for FollowerService.List
 type FollowerListParams struct {
-	UserID              int64  `url:"user_id,omitempty"`
+	UserID              UserID `url:"user_id,omitempty"`
 	ScreenName          string `url:"screen_name,omitempty"`
 	Cursor              int64  `url:"cursor,omitempty"`
 	Count               int    `url:"count,omitempty"`
@@ -66,8 +86,27 @@ type FollowerListParams struct {
 // List returns a cursored collection of Users following the specified user.
 // https://dev.twitter.com/rest/reference/get/followers/list
 func (s *FollowerService) List(params *FollowerListParams) (*Followers, *http.Response, error) {
+	return s.ListWithContext(context.Background(), params)
+}
+
+// ListWithContext is like List but uses the given context for the request.
+func (s *FollowerService) ListWithContext(ctx context.Context, params *FollowerListParams) (*Followers, *http.Response, error) {
 	followers := new(Followers)
 	apiError := new(APIError)
-	resp, err := s.sling.New().Get("list.json").QueryStruct(params).Receive(followers, apiError)
-	return followers, resp, relevantError(err, *apiError)
+	resp, err := receive(ctx, s.sling.New().Get("list.json").QueryStruct(params), followers, apiError)
+	return followers, resp, relevantError(resp, err, *apiError)
+}
+
+// ListPaginator returns a Paginator over the followers returned by
+// List, fetching pages as needed.
+func (s *FollowerService) ListPaginator(ctx context.Context, params *FollowerListParams) *Paginator[User] {
+	p := FollowerListParams{}
+	if params != nil {
+		p = *params
+	}
+	return NewCursorPaginator(ctx, func(ctx context.Context, cursor int64) ([]User, int64, *http.Response, error) {
+		p.Cursor = cursor
+		page, resp, err := s.ListWithContext(ctx, &p)
+		return page.Users, page.NextCursor, resp, err
+	})
 }
@@ -1,6 +1,7 @@
 package twitter
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/dghubble/sling"
@@ -8,11 +9,11 @@ import (
 
 // FriendIDs is a cursored collection of friend ids.
 type FriendIDs struct {
-	IDs               []int64 `json:"ids"`
-	NextCursor        int64   `json:"next_cursor"`
-	NextCursorStr     string  `json:"next_cursor_str"`
-	PreviousCursor    int64   `json:"previous_cursor"`
-	PreviousCursorStr string  `json:"previous_cursor_str"`
+	IDs               []UserID `json:"ids"`
+	NextCursor        int64    `json:"next_cursor"`
+	NextCursorStr     string   `json:"next_cursor_str"`
+	PreviousCursor    int64    `json:"previous_cursor"`
+	PreviousCursorStr string   `json:"previous_cursor_str"`
 }
 
 // Friends is a cursored collection of friends.
@@ -38,7 +39,7 @@ func newFriendService(sling *sling.Sling) *FriendService {
 
 // FriendIDParams are the parameters for FriendService.Ids
 type FriendIDParams struct {
-	UserID     int64  `url:"user_id,omitempty"`
+	UserID     UserID `url:"user_id,omitempty"`
 	ScreenName string `url:"screen_name,omitempty"`
 	Cursor     int64  `url:"cursor,omitempty"`
 	Count      int    `url:"count,omitempty"`
@@ -47,15 +48,34 @@ type FriendIDParams struct {
 // IDs returns a cursored collection of user ids that the specified user is following.
 // https://dev.twitter.com/rest/reference/get/friends/ids
 func (s *FriendService) IDs(params *FriendIDParams) (*FriendIDs, *http.Response, error) {
+	return s.IDsWithContext(context.Background(), params)
+}
+
+// IDsWithContext is like IDs but uses the given context for the request.
+func (s *FriendService) IDsWithContext(ctx context.Context, params *FriendIDParams) (*FriendIDs, *http.Response, error) {
 	ids := new(FriendIDs)
 	apiError := new(APIError)
-	resp, err := s.sling.New().Get("ids.json").QueryStruct(params).Receive(ids, apiError)
-	return ids, resp, relevantError(err, *apiError)
+	resp, err := receive(ctx, s.sling.New().Get("ids.json").QueryStruct(params), ids, apiError)
+	return ids, resp, relevantError(resp, err, *apiError)
+}
+
+// IDsPaginator returns a Paginator over the friend ids returned by
+// IDs, fetching pages as needed.
+func (s *FriendService) IDsPaginator(ctx context.Context, params *FriendIDParams) *Paginator[UserID] {
+	p := FriendIDParams{}
+	if params != nil {
+		p = *params
+	}
+	return NewCursorPaginator(ctx, func(ctx context.Context, cursor int64) ([]UserID, int64, *http.Response, error) {
+		p.Cursor = cursor
+		page, resp, err := s.IDsWithContext(ctx, &p)
+		return page.IDs, page.NextCursor, resp, err
+	})
 }
 
 // FriendListParams are the parameters for FriendService.List
 type FriendListParams struct {
-	UserID              int64  `url:"user_id,omitempty"`
+	UserID              UserID `url:"user_id,omitempty"`
 	ScreenName          string `url:"screen_name,omitempty"`
 	Cursor              int64  `url:"cursor,omitempty"`
 	Count               int    `url:"count,omitempty"`
@@ -66,8 +86,27 @@ type FriendListParams struct {
 // List returns a cursored collection of Users that the specified user is following.
 // https://dev.twitter.com/rest/reference/get/friends/list
 func (s *FriendService) List(params *FriendListParams) (*Friends, *http.Response, error) {
+	return s.ListWithContext(context.Background(), params)
+}
+
+// ListWithContext is like List but uses the given context for the request.
+func (s *FriendService) ListWithContext(ctx context.Context, params *FriendListParams) (*Friends, *http.Response, error) {
 	friends := new(Friends)
 	apiError := new(APIError)
-	resp, err := s.sling.New().Get("list.json").QueryStruct(params).Receive(friends, apiError)
-	return friends, resp, relevantError(err, *apiError)
+	resp, err := receive(ctx, s.sling.New().Get("list.json").QueryStruct(params), friends, apiError)
+	return friends, resp, relevantError(resp, err, *apiError)
+}
+
+// ListPaginator returns a Paginator over the friends returned by
+// List, fetching pages as needed.
+func (s *FriendService) ListPaginator(ctx context.Context, params *FriendListParams) *Paginator[User] {
+	p := FriendListParams{}
+	if params != nil {
+		p = *params
+	}
+	return NewCursorPaginator(ctx, func(ctx context.Context, cursor int64) ([]User, int64, *http.Response, error) {
+		p.Cursor = cursor
+		page, resp, err := s.ListWithContext(ctx, &p)
+		return page.Users, page.NextCursor, resp, err
+	})
 }
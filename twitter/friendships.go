@@ -1,6 +1,7 @@
 package twitter
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/dghubble/sling"
@@ -22,7 +23,7 @@ func newFriendshipService(sling *sling.Sling) *FriendshipService {
 // FriendshipCreateParams are parameters for FriendshipService.Create
 type FriendshipCreateParams struct {
 	ScreenName string `url:"screen_name,omitempty"`
-	UserID     int64  `url:"user_id,omitempty"`
+	UserID     UserID `url:"user_id,omitempty"`
 	Follow     *bool  `url:"follow,omitempty"`
 }
 
@@ -31,17 +32,22 @@ type FriendshipCreateParams struct {
 // Requires a user auth context.
 // https://dev.twitter.com/rest/reference/post/friendships/create
 func (s *FriendshipService) Create(params *FriendshipCreateParams) (*User, *http.Response, error) {
+	return s.CreateWithContext(context.Background(), params)
+}
+
+// CreateWithContext is like Create but uses the given context for the request.
+func (s *FriendshipService) CreateWithContext(ctx context.Context, params *FriendshipCreateParams) (*User, *http.Response, error) {
 	user := new(User)
 	apiError := new(APIError)
-	resp, err := s.sling.New().Post("create.json").QueryStruct(params).Receive(user, apiError)
-	return user, resp, relevantError(err, *apiError)
+	resp, err := receive(ctx, s.sling.New().Post("create.json").QueryStruct(params), user, apiError)
+	return user, resp, relevantError(resp, err, *apiError)
 }
 
 // FriendshipShowParams are paramenters for FriendshipService.Show
 type FriendshipShowParams struct {
-	SourceID         int64  `url:"source_id,omitempty"`
+	SourceID         UserID `url:"source_id,omitempty"`
 	SourceScreenName string `url:"source_screen_name,omitempty"`
-	TargetID         int64  `url:"target_id,omitempty"`
+	TargetID         UserID `url:"target_id,omitempty"`
 	TargetScreenName string `url:"target_screen_name,omitempty"`
 }
 
@@ -49,10 +55,15 @@ type FriendshipShowParams struct {
 // Requires a user auth or an app context.
 // https://dev.twitter.com/rest/reference/get/friendships/show
 func (s *FriendshipService) Show(params *FriendshipShowParams) (*Relationship, *http.Response, error) {
+	return s.ShowWithContext(context.Background(), params)
+}
+
+// ShowWithContext is like Show but uses the given context for the request.
+func (s *FriendshipService) ShowWithContext(ctx context.Context, params *FriendshipShowParams) (*Relationship, *http.Response, error) {
 	response := new(RelationshipResponse)
 	apiError := new(APIError)
-	resp, err := s.sling.New().Get("show.json").QueryStruct(params).Receive(response, apiError)
-	return response.Relationship, resp, relevantError(err, *apiError)
+	resp, err := receive(ctx, s.sling.New().Get("show.json").QueryStruct(params), response, apiError)
+	return response.Relationship, resp, relevantError(resp, err, *apiError)
 }
 
 // RelationshipResponse contains a relationship.
@@ -68,7 +79,7 @@ type Relationship struct {
 
 // RelationshipSource represents the source user.
 type RelationshipSource struct {
-	ID                   int64  `json:"id"`
+	ID                   UserID `json:"id"`
 	IDStr                string `json:"id_str"`
 	ScreenName           string `json:"screen_name"`
 	Following            bool   `json:"following"`
@@ -84,7 +95,7 @@ type RelationshipSource struct {
 
 // RelationshipTarget represents the target user.
 type RelationshipTarget struct {
-	ID         int64  `json:"id"`
+	ID         UserID `json:"id"`
 	IDStr      string `json:"id_str"`
 	ScreenName string `json:"screen_name"`
 	Following  bool   `json:"following"`
@@ -94,7 +105,7 @@ type RelationshipTarget struct {
 // FriendshipDestroyParams are paramenters for FriendshipService.Destroy
 type FriendshipDestroyParams struct {
 	ScreenName string `url:"screen_name,omitempty"`
-	UserID     int64  `url:"user_id,omitempty"`
+	UserID     UserID `url:"user_id,omitempty"`
 }
 
 // Destroy destroys a friendship to (i.e. unfollows) the specified user and
@@ -102,10 +113,15 @@ type FriendshipDestroyParams struct {
 // Requires a user auth context.
 // https://dev.twitter.com/rest/reference/post/friendships/destroy
 func (s *FriendshipService) Destroy(params *FriendshipDestroyParams) (*User, *http.Response, error) {
+	return s.DestroyWithContext(context.Background(), params)
+}
+
+// DestroyWithContext is like Destroy but uses the given context for the request.
+func (s *FriendshipService) DestroyWithContext(ctx context.Context, params *FriendshipDestroyParams) (*User, *http.Response, error) {
 	user := new(User)
 	apiError := new(APIError)
-	resp, err := s.sling.New().Post("destroy.json").QueryStruct(params).Receive(user, apiError)
-	return user, resp, relevantError(err, *apiError)
+	resp, err := receive(ctx, s.sling.New().Post("destroy.json").QueryStruct(params), user, apiError)
+	return user, resp, relevantError(resp, err, *apiError)
 }
 
 // FriendshipPendingParams are paramenters for FriendshipService.Outgoing
@@ -117,18 +133,56 @@ type FriendshipPendingParams struct {
 // user has a pending follow request.
 // https://dev.twitter.com/rest/reference/get/friendships/outgoing
 func (s *FriendshipService) Outgoing(params *FriendshipPendingParams) (*FriendIDs, *http.Response, error) {
+	return s.OutgoingWithContext(context.Background(), params)
+}
+
+// OutgoingWithContext is like Outgoing but uses the given context for the request.
+func (s *FriendshipService) OutgoingWithContext(ctx context.Context, params *FriendshipPendingParams) (*FriendIDs, *http.Response, error) {
 	ids := new(FriendIDs)
 	apiError := new(APIError)
-	resp, err := s.sling.New().Get("outgoing.json").QueryStruct(params).Receive(ids, apiError)
-	return ids, resp, relevantError(err, *apiError)
+	resp, err := receive(ctx, s.sling.New().Get("outgoing.json").QueryStruct(params), ids, apiError)
+	return ids, resp, relevantError(resp, err, *apiError)
+}
+
+// OutgoingPaginator returns a Paginator over the user ids returned by
+// Outgoing, fetching pages as needed.
+func (s *FriendshipService) OutgoingPaginator(ctx context.Context, params *FriendshipPendingParams) *Paginator[UserID] {
+	p := FriendshipPendingParams{}
+	if params != nil {
+		p = *params
+	}
+	return NewCursorPaginator(ctx, func(ctx context.Context, cursor int64) ([]UserID, int64, *http.Response, error) {
+		p.Cursor = cursor
+		page, resp, err := s.OutgoingWithContext(ctx, &p)
+		return page.IDs, page.NextCursor, resp, err
+	})
 }
 
 // Incoming returns a collection of numeric IDs for every user who has a pending request to
 // follow the authenticating user.
 // https://dev.twitter.com/rest/reference/get/friendships/incoming
 func (s *FriendshipService) Incoming(params *FriendshipPendingParams) (*FriendIDs, *http.Response, error) {
+	return s.IncomingWithContext(context.Background(), params)
+}
+
+// IncomingWithContext is like Incoming but uses the given context for the request.
+func (s *FriendshipService) IncomingWithContext(ctx context.Context, params *FriendshipPendingParams) (*FriendIDs, *http.Response, error) {
 	ids := new(FriendIDs)
 	apiError := new(APIError)
-	resp, err := s.sling.New().Get("incoming.json").QueryStruct(params).Receive(ids, apiError)
-	return ids, resp, relevantError(err, *apiError)
+	resp, err := receive(ctx, s.sling.New().Get("incoming.json").QueryStruct(params), ids, apiError)
+	return ids, resp, relevantError(resp, err, *apiError)
+}
+
+// IncomingPaginator returns a Paginator over the user ids returned by
+// Incoming, fetching pages as needed.
+func (s *FriendshipService) IncomingPaginator(ctx context.Context, params *FriendshipPendingParams) *Paginator[UserID] {
+	p := FriendshipPendingParams{}
+	if params != nil {
+		p = *params
+	}
+	return NewCursorPaginator(ctx, func(ctx context.Context, cursor int64) ([]UserID, int64, *http.Response, error) {
+		p.Cursor = cursor
+		page, resp, err := s.IncomingWithContext(ctx, &p)
+		return page.IDs, page.NextCursor, resp, err
+	})
 }
@@ -0,0 +1,167 @@
+package twitter
+
+// Includes represents the list of entities that a tweet includes, such as other tweets, users, media, places or polls.
+type Includes struct {
+	Tweets []*Tweet       `json:"tweets"`
+	Users  []*User        `json:"users"`
+	Media  []*MediaEntity `json:"media"`
+	Places []*Place       `json:"places"`
+	Polls  []*Poll        `json:"polls"`
+}
+
+// TweetByID returns the included Tweet with the given id, or nil.
+func (i *Includes) TweetByID(id TweetID) *Tweet {
+	if i == nil {
+		return nil
+	}
+	for _, tweet := range i.Tweets {
+		if tweet.ID == id {
+			return tweet
+		}
+	}
+	return nil
+}
+
+// UserByID returns the included User with the given id, or nil.
+func (i *Includes) UserByID(id UserID) *User {
+	if i == nil {
+		return nil
+	}
+	for _, user := range i.Users {
+		if user.ID == id {
+			return user
+		}
+	}
+	return nil
+}
+
+// MediaByKey returns the included media with the given media key, or nil.
+func (i *Includes) MediaByKey(key string) *MediaEntity {
+	if i == nil {
+		return nil
+	}
+	for _, media := range i.Media {
+		if media.MediaKey == key {
+			return media
+		}
+	}
+	return nil
+}
+
+// PlaceByID returns the included Place with the given id, or nil.
+func (i *Includes) PlaceByID(id string) *Place {
+	if i == nil {
+		return nil
+	}
+	for _, place := range i.Places {
+		if place.ID == id {
+			return place
+		}
+	}
+	return nil
+}
+
+// PollByID returns the included Poll with the given id, or nil.
+func (i *Includes) PollByID(id string) *Poll {
+	if i == nil {
+		return nil
+	}
+	for _, poll := range i.Polls {
+		if poll.ID == id {
+			return poll
+		}
+	}
+	return nil
+}
+
+// hydrate links the given Tweets, and the included Tweets themselves, to
+// the Includes so their accessors resolve expansions.
+func (i *Includes) hydrate(tweets ...*Tweet) {
+	for _, tweet := range tweets {
+		if tweet != nil {
+			tweet.includes = i
+		}
+	}
+	if i == nil {
+		return
+	}
+	for _, tweet := range i.Tweets {
+		if tweet != nil {
+			tweet.includes = i
+		}
+	}
+}
+
+// Hydrate links the Tweet to the Includes returned alongside it, so that
+// accessors such as Author, Media and QuotedTweet resolve the Tweet's
+// references. Tweets returned by TweetService, SearchService v2 methods and
+// streams are hydrated already.
+func (t *Tweet) Hydrate(includes *Includes) {
+	includes.hydrate(t)
+}
+
+// Author returns the User who posted the Tweet, if the author_id expansion
+// was requested.
+func (t Tweet) Author() *User {
+	return t.includes.UserByID(t.AuthorID)
+}
+
+// Media returns the media attached to the Tweet, if the
+// attachments.media_keys expansion was requested.
+func (t Tweet) Media() []*MediaEntity {
+	var media []*MediaEntity
+	for _, key := range t.Attachments.MediaKeys {
+		if m := t.includes.MediaByKey(key); m != nil {
+			media = append(media, m)
+		}
+	}
+	return media
+}
+
+// Poll returns the poll attached to the Tweet, if the attachments.poll_ids
+// expansion was requested.
+func (t Tweet) Poll() *Poll {
+	for _, id := range t.Attachments.PollID {
+		if poll := t.includes.PollByID(id); poll != nil {
+			return poll
+		}
+	}
+	return nil
+}
+
+// Place returns the Place the Tweet is tagged with, if the geo.place_id
+// expansion was requested.
+func (t Tweet) Place() *Place {
+	if t.Geo == nil {
+		return nil
+	}
+	return t.includes.PlaceByID(t.Geo.PlaceID)
+}
+
+// QuotedTweet returns the Tweet quoted by the Tweet, if the
+// referenced_tweets.id expansion was requested.
+func (t Tweet) QuotedTweet() *Tweet {
+	return t.referencedTweet("quoted")
+}
+
+// RepliedToTweet returns the Tweet the Tweet replies to, if the
+// referenced_tweets.id expansion was requested.
+func (t Tweet) RepliedToTweet() *Tweet {
+	return t.referencedTweet("replied_to")
+}
+
+// RetweetedTweet returns the Tweet retweeted by the Tweet, if the
+// referenced_tweets.id expansion was requested.
+func (t Tweet) RetweetedTweet() *Tweet {
+	return t.referencedTweet("retweeted")
+}
+
+// referencedTweet returns the included Tweet referenced with the given type.
+func (t Tweet) referencedTweet(referenceType string) *Tweet {
+	for _, ref := range t.ReferencedTweets {
+		if ref.Type == referenceType {
+			return t.includes.TweetByID(ref.ID)
+		}
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package twitter
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/dghubble/sling"
@@ -8,19 +9,19 @@ import (
 
 // List represents a Twitter List.
 type List struct {
-	Slug            string `json:"slug"`
-	Name            string `json:"name"`
-	CreatedAt       string `json:"created_at"`
-	URI             string `json:"uri"`
-	SubscriberCount int    `json:"subscriber_count"`
-	IDStr           string `json:"id_str"`
-	MemberCount     int    `json:"member_count"`
-	Mode            string `json:"mode"`
-	ID              int64  `json:"id"`
-	FullName        string `json:"full_name"`
-	Description     string `json:"description"`
-	User            *User  `json:"user"`
-	Following       bool   `json:"following"`
+	Slug            string    `json:"slug"`
+	Name            string    `json:"name"`
+	CreatedAt       Timestamp `json:"created_at"`
+	URI             string    `json:"uri"`
+	SubscriberCount int       `json:"subscriber_count"`
+	IDStr           string    `json:"id_str"`
+	MemberCount     int       `json:"member_count"`
+	Mode            string    `json:"mode"`
+	ID              ListID    `json:"id"`
+	FullName        string    `json:"full_name"`
+	Description     string    `json:"description"`
+	User            *User     `json:"user"`
+	Following       bool      `json:"following"`
 }
 
 // Members is a cursored collection of list members.
@@ -82,7 +83,7 @@ func newListService(sling *sling.Sling) *ListsService {
 
 // ListsListParams are the parameters for ListsService.List
 type ListsListParams struct {
-	UserID     int64  `url:"user_id,omitempty"`
+	UserID     UserID `url:"user_id,omitempty"`
 	ScreenName string `url:"screen_name,omitempty"`
 	Reverse    bool   `url:"reverse,omitempty"`
 }
@@ -90,18 +91,23 @@ type ListsListParams struct {
 // List eturns all lists the authenticating or specified user subscribes to, including their own.
 // https://developer.twitter.com/en/docs/accounts-and-users/create-manage-lists/api-reference/get-lists-list
 func (s *ListsService) List(params *ListsListParams) ([]List, *http.Response, error) {
+	return s.ListWithContext(context.Background(), params)
+}
+
+// ListWithContext is like List but uses the given context for the request.
+func (s *ListsService) ListWithContext(ctx context.Context, params *ListsListParams) ([]List, *http.Response, error) {
 	list := new([]List)
 	apiError := new(APIError)
-	resp, err := s.sling.New().Get("list.json").QueryStruct(params).Receive(list, apiError)
-	return *list, resp, relevantError(err, *apiError)
+	resp, err := receive(ctx, s.sling.New().Get("list.json").QueryStruct(params), list, apiError)
+	return *list, resp, relevantError(resp, err, *apiError)
 }
 
 // ListsMembersParams are the parameters for ListsService.Members
 type ListsMembersParams struct {
-	ListID          int64  `url:"list_id,omitempty"`
+	ListID          ListID `url:"list_id,omitempty"`
 	Slug            string `url:"slug,omitempty"`
 	OwnerScreenName string `url:"owner_screen_name,omitempty"`
-	OwnerID         int64  `url:"owner_id,omitempty"`
+	OwnerID         UserID `url:"owner_id,omitempty"`
 	Count           int    `url:"count,omitempty"`
 	Cursor          int64  `url:"cursor,omitempty"`
 	IncludeEntities *bool  `url:"include_entities,omitempty"`
@@ -111,20 +117,39 @@ type ListsMembersParams struct {
 // Members returns the members of the specified list
 // https://developer.twitter.com/en/docs/accounts-and-users/create-manage-lists/api-reference/get-lists-members
 func (s *ListsService) Members(params *ListsMembersParams) (*Members, *http.Response, error) {
+	return s.MembersWithContext(context.Background(), params)
+}
+
+// MembersWithContext is like Members but uses the given context for the request.
+func (s *ListsService) MembersWithContext(ctx context.Context, params *ListsMembersParams) (*Members, *http.Response, error) {
 	members := new(Members)
 	apiError := new(APIError)
-	resp, err := s.sling.New().Get("members.json").QueryStruct(params).Receive(members, apiError)
-	return members, resp, relevantError(err, *apiError)
+	resp, err := receive(ctx, s.sling.New().Get("members.json").QueryStruct(params), members, apiError)
+	return members, resp, relevantError(resp, err, *apiError)
+}
+
+// MembersPaginator returns a Paginator over the list members returned by
+// Members, fetching pages as needed.
+func (s *ListsService) MembersPaginator(ctx context.Context, params *ListsMembersParams) *Paginator[User] {
+	p := ListsMembersParams{}
+	if params != nil {
+		p = *params
+	}
+	return NewCursorPaginator(ctx, func(ctx context.Context, cursor int64) ([]User, int64, *http.Response, error) {
+		p.Cursor = cursor
+		page, resp, err := s.MembersWithContext(ctx, &p)
+		return page.Users, page.NextCursor, resp, err
+	})
 }
 
 // ListsMembersShowParams are the parameters for ListsService.MembersShow
 type ListsMembersShowParams struct {
-	ListID          int64  `url:"list_id,omitempty"`
+	ListID          ListID `url:"list_id,omitempty"`
 	Slug            string `url:"slug,omitempty"`
-	UserID          int64  `url:"user_id,omitempty"`
+	UserID          UserID `url:"user_id,omitempty"`
 	ScreenName      string `url:"screen_name,omitempty"`
 	OwnerScreenName string `url:"owner_screen_name,omitempty"`
-	OwnerID         int64  `url:"owner_id,omitempty"`
+	OwnerID         UserID `url:"owner_id,omitempty"`
 	IncludeEntities *bool  `url:"include_entities,omitempty"`
 	SkipStatus      *bool  `url:"skip_status,omitempty"`
 }
@@ -132,15 +157,20 @@ type ListsMembersShowParams struct {
 // MembersShow checks if the specified user is a member of the specified list.
 // https://developer.twitter.com/en/docs/accounts-and-users/create-manage-lists/api-reference/get-lists-members-show
 func (s *ListsService) MembersShow(params *ListsMembersShowParams) (*User, *http.Response, error) {
+	return s.MembersShowWithContext(context.Background(), params)
+}
+
+// MembersShowWithContext is like MembersShow but uses the given context for the request.
+func (s *ListsService) MembersShowWithContext(ctx context.Context, params *ListsMembersShowParams) (*User, *http.Response, error) {
 	user := new(User)
 	apiError := new(APIError)
-	resp, err := s.sling.New().Get("members/show.json").QueryStruct(params).Receive(user, apiError)
-	return user, resp, relevantError(err, *apiError)
+	resp, err := receive(ctx, s.sling.New().Get("members/show.json").QueryStruct(params), user, apiError)
+	return user, resp, relevantError(resp, err, *apiError)
 }
 
 // ListsMembershipsParams are the parameters for ListsService.Memberships
 type ListsMembershipsParams struct {
-	UserID             int64  `url:"user_id,omitempty"`
+	UserID             UserID `url:"user_id,omitempty"`
 	ScreenName         string `url:"screen_name,omitempty"`
 	Count              int    `url:"count,omitempty"`
 	Cursor             int64  `url:"cursor,omitempty"`
@@ -150,15 +180,34 @@ type ListsMembershipsParams struct {
 // Memberships returns the lists the specified user has been added to.
 // https://developer.twitter.com/en/docs/accounts-and-users/create-manage-lists/api-reference/get-lists-memberships
 func (s *ListsService) Memberships(params *ListsMembershipsParams) (*Membership, *http.Response, error) {
+	return s.MembershipsWithContext(context.Background(), params)
+}
+
+// MembershipsWithContext is like Memberships but uses the given context for the request.
+func (s *ListsService) MembershipsWithContext(ctx context.Context, params *ListsMembershipsParams) (*Membership, *http.Response, error) {
 	membership := new(Membership)
 	apiError := new(APIError)
-	resp, err := s.sling.New().Get("memberships.json").QueryStruct(params).Receive(membership, apiError)
-	return membership, resp, relevantError(err, *apiError)
+	resp, err := receive(ctx, s.sling.New().Get("memberships.json").QueryStruct(params), membership, apiError)
+	return membership, resp, relevantError(resp, err, *apiError)
+}
+
+// MembershipsPaginator returns a Paginator over the lists returned by
+// Memberships, fetching pages as needed.
+func (s *ListsService) MembershipsPaginator(ctx context.Context, params *ListsMembershipsParams) *Paginator[List] {
+	p := ListsMembershipsParams{}
+	if params != nil {
+		p = *params
+	}
+	return NewCursorPaginator(ctx, func(ctx context.Context, cursor int64) ([]List, int64, *http.Response, error) {
+		p.Cursor = cursor
+		page, resp, err := s.MembershipsWithContext(ctx, &p)
+		return page.Lists, page.NextCursor, resp, err
+	})
 }
 
 // ListsOwnershipsParams are the parameters for ListsService.Ownerships
 type ListsOwnershipsParams struct {
-	UserID     int64  `url:"user_id,omitempty"`
+	UserID     UserID `url:"user_id,omitempty"`
 	ScreenName string `url:"screen_name,omitempty"`
 	Count      int    `url:"count,omitempty"`
 	Cursor     int64  `url:"cursor,omitempty"`
@@ -167,57 +216,102 @@ type ListsOwnershipsParams struct {
 // Ownerships returns the lists owned by the specified Twitter user.
 // https://developer.twitter.com/en/docs/accounts-and-users/create-manage-lists/api-reference/get-lists-ownerships
 func (s *ListsService) Ownerships(params *ListsOwnershipsParams) (*Ownership, *http.Response, error) {
+	return s.OwnershipsWithContext(context.Background(), params)
+}
+
+// OwnershipsWithContext is like Ownerships but uses the given context for the request.
+func (s *ListsService) OwnershipsWithContext(ctx context.Context, params *ListsOwnershipsParams) (*Ownership, *http.Response, error) {
 	ownership := new(Ownership)
 	apiError := new(APIError)
-	resp, err := s.sling.New().Get("ownerships.json").QueryStruct(params).Receive(ownership, apiError)
-	return ownership, resp, relevantError(err, *apiError)
+	resp, err := receive(ctx, s.sling.New().Get("ownerships.json").QueryStruct(params), ownership, apiError)
+	return ownership, resp, relevantError(resp, err, *apiError)
+}
+
+// OwnershipsPaginator returns a Paginator over the lists returned by
+// Ownerships, fetching pages as needed.
+func (s *ListsService) OwnershipsPaginator(ctx context.Context, params *ListsOwnershipsParams) *Paginator[List] {
+	p := ListsOwnershipsParams{}
+	if params != nil {
+		p = *params
+	}
+	return NewCursorPaginator(ctx, func(ctx context.Context, cursor int64) ([]List, int64, *http.Response, error) {
+		p.Cursor = cursor
+		page, resp, err := s.OwnershipsWithContext(ctx, &p)
+		return page.Lists, page.NextCursor, resp, err
+	})
 }
 
 // ListsShowParams are the parameters for ListsService.Show
 type ListsShowParams struct {
-	ListID          int64  `url:"list_id,omitempty"`
+	ListID          ListID `url:"list_id,omitempty"`
 	Slug            string `url:"slug,omitempty"`
 	OwnerScreenName string `url:"owner_screen_name,omitempty"`
-	OwnerID         int64  `url:"owner_id,omitempty"`
+	OwnerID         UserID `url:"owner_id,omitempty"`
 }
 
 // Show returns the specified list.
 // https://developer.twitter.com/en/docs/accounts-and-users/create-manage-lists/api-reference/get-lists-show
 func (s *ListsService) Show(params *ListsShowParams) (*List, *http.Response, error) {
+	return s.ShowWithContext(context.Background(), params)
+}
+
+// ShowWithContext is like Show but uses the given context for the request.
+func (s *ListsService) ShowWithContext(ctx context.Context, params *ListsShowParams) (*List, *http.Response, error) {
 	list := new(List)
 	apiError := new(APIError)
-	resp, err := s.sling.New().Get("show.json").QueryStruct(params).Receive(list, apiError)
-	return list, resp, relevantError(err, *apiError)
+	resp, err := receive(ctx, s.sling.New().Get("show.json").QueryStruct(params), list, apiError)
+	return list, resp, relevantError(resp, err, *apiError)
 }
 
 // ListsStatusesParams are the parameters for ListsService.Statuses
 type ListsStatusesParams struct {
-	ListID          int64  `url:"list_id,omitempty"`
-	Slug            string `url:"slug,omitempty"`
-	OwnerScreenName string `url:"owner_screen_name,omitempty"`
-	OwnerID         int64  `url:"owner_id,omitempty"`
-	SinceID         int64  `url:"since_id,omitempty"`
-	MaxID           int64  `url:"max_id,omitempty"`
-	Count           int    `url:"count,omitempty"`
-	IncludeEntities *bool  `url:"include_entities,omitempty"`
-	IncludeRetweets *bool  `url:"include_rts,omitempty"`
+	ListID          ListID  `url:"list_id,omitempty"`
+	Slug            string  `url:"slug,omitempty"`
+	OwnerScreenName string  `url:"owner_screen_name,omitempty"`
+	OwnerID         UserID  `url:"owner_id,omitempty"`
+	SinceID         TweetID `url:"since_id,omitempty"`
+	MaxID           TweetID `url:"max_id,omitempty"`
+	Count           int     `url:"count,omitempty"`
+	IncludeEntities *bool   `url:"include_entities,omitempty"`
+	IncludeRetweets *bool   `url:"include_rts,omitempty"`
 }
 
 // Statuses returns a timeline of tweets authored by members of the specified list.
 // https://developer.twitter.com/en/docs/accounts-and-users/create-manage-lists/api-reference/get-lists-statuses
 func (s *ListsService) Statuses(params *ListsStatusesParams) ([]Tweet, *http.Response, error) {
+	return s.StatusesWithContext(context.Background(), params)
+}
+
+// StatusesWithContext is like Statuses but uses the given context for the request.
+func (s *ListsService) StatusesWithContext(ctx context.Context, params *ListsStatusesParams) ([]Tweet, *http.Response, error) {
 	tweets := new([]Tweet)
 	apiError := new(APIError)
-	resp, err := s.sling.New().Get("statuses.json").QueryStruct(params).Receive(tweets, apiError)
-	return *tweets, resp, relevantError(err, *apiError)
+	resp, err := receive(ctx, s.sling.New().Get("statuses.json").QueryStruct(params), tweets, apiError)
+	return *tweets, resp, relevantError(resp, err, *apiError)
+}
+
+// StatusesPaginator returns a Paginator over the Tweets returned by
+// Statuses, fetching older pages with max_id as needed.
+func (s *ListsService) StatusesPaginator(ctx context.Context, params *ListsStatusesParams) *Paginator[Tweet] {
+	p := ListsStatusesParams{}
+	if params != nil {
+		p = *params
+	}
+	return NewMaxIDPaginator(ctx, func(ctx context.Context, maxID TweetID) ([]Tweet, *http.Response, error) {
+		if maxID != 0 {
+			p.MaxID = maxID
+		}
+		page, resp, err := s.StatusesWithContext(ctx, &p)
+		return page, resp, err
+	}, tweetID)
 }
 
 // ListsSubscribersParams are the parameters for ListsService.Subscribers
 type ListsSubscribersParams struct {
-	ListID          int64  `url:"list_id,omitempty"`
+	ListID          ListID `url:"list_id,omitempty"`
 	Slug            string `url:"slug,omitempty"`
 	OwnerScreenName string `url:"owner_screen_name,omitempty"`
-	OwnerID         int64  `url:"owner_id,omitempty"`
+	OwnerID         UserID `url:"owner_id,omitempty"`
 	Count           int    `url:"count,omitempty"`
 	Cursor          int64  `url:"cursor,omitempty"`
 	IncludeEntities *bool  `url:"include_entities,omitempty"`
@@ -227,19 +321,38 @@ type ListsSubscribersParams struct {
 // Subscribers returns the subscribers of the specified list.
 // https://developer.twitter.com/en/docs/accounts-and-users/create-manage-lists/api-reference/get-lists-subscribers
 func (s *ListsService) Subscribers(params *ListsSubscribersParams) (*Subscribers, *http.Response, error) {
+	return s.SubscribersWithContext(context.Background(), params)
+}
+
+// SubscribersWithContext is like Subscribers but uses the given context for the request.
+func (s *ListsService) SubscribersWithContext(ctx context.Context, params *ListsSubscribersParams) (*Subscribers, *http.Response, error) {
 	subscribers := new(Subscribers)
 	apiError := new(APIError)
-	resp, err := s.sling.New().Get("subscribers.json").QueryStruct(params).Receive(subscribers, apiError)
-	return subscribers, resp, relevantError(err, *apiError)
+	resp, err := receive(ctx, s.sling.New().Get("subscribers.json").QueryStruct(params), subscribers, apiError)
+	return subscribers, resp, relevantError(resp, err, *apiError)
+}
+
+// SubscribersPaginator returns a Paginator over the subscribers returned by
+// Subscribers, fetching pages as needed.
+func (s *ListsService) SubscribersPaginator(ctx context.Context, params *ListsSubscribersParams) *Paginator[User] {
+	p := ListsSubscribersParams{}
+	if params != nil {
+		p = *params
+	}
+	return NewCursorPaginator(ctx, func(ctx context.Context, cursor int64) ([]User, int64, *http.Response, error) {
+		p.Cursor = cursor
+		page, resp, err := s.SubscribersWithContext(ctx, &p)
+		return page.Users, page.NextCursor, resp, err
+	})
 }
 
 // ListsSubscribersShowParams are the parameters for ListsService.SubscribersShow
 type ListsSubscribersShowParams struct {
 	OwnerScreenName string `url:"owner_screen_name,omitempty"`
-	OwnerID         int64  `url:"owner_id,omitempty"`
-	ListID          int64  `url:"list_id,omitempty"`
+	OwnerID         UserID `url:"owner_id,omitempty"`
+	ListID          ListID `url:"list_id,omitempty"`
 	Slug            string `url:"slug,omitempty"`
-	UserID          int64  `url:"user_id,omitempty"`
+	UserID          UserID `url:"user_id,omitempty"`
 	ScreenName      string `url:"screen_name,omitempty"`
 	IncludeEntities *bool  `url:"include_entities,omitempty"`
 	SkipStatus      *bool  `url:"skip_status,omitempty"`
@@ -248,15 +361,20 @@ type ListsSubscribersShowParams struct {
 // SubscribersShow returns the user if they are a subscriber to the list.
 // https://developer.twitter.com/en/docs/accounts-and-users/create-manage-lists/api-reference/get-lists-subscribers-show
 func (s *ListsService) SubscribersShow(params *ListsSubscribersShowParams) (*User, *http.Response, error) {
+	return s.SubscribersShowWithContext(context.Background(), params)
+}
+
+// SubscribersShowWithContext is like SubscribersShow but uses the given context for the request.
+func (s *ListsService) SubscribersShowWithContext(ctx context.Context, params *ListsSubscribersShowParams) (*User, *http.Response, error) {
 	user := new(User)
 	apiError := new(APIError)
-	resp, err := s.sling.New().Get("subscribers/show.json").QueryStruct(params).Receive(user, apiError)
-	return user, resp, relevantError(err, *apiError)
+	resp, err := receive(ctx, s.sling.New().Get("subscribers/show.json").QueryStruct(params), user, apiError)
+	return user, resp, relevantError(resp, err, *apiError)
 }
 
 // ListsSubscriptionsParams are the parameters for ListsService.Subscriptions
 type ListsSubscriptionsParams struct {
-	UserID     int64  `url:"user_id,omitempty"`
+	UserID     UserID `url:"user_id,omitempty"`
 	ScreenName string `url:"screen_name,omitempty"`
 	Count      int    `url:"count,omitempty"`
 	Cursor     int64  `url:"cursor,omitempty"`
@@ -265,10 +383,29 @@ type ListsSubscriptionsParams struct {
 // Subscriptions returns a collection of the lists the specified user is subscribed to.
 // https://developer.twitter.com/en/docs/accounts-and-users/create-manage-lists/api-reference/get-lists-subscriptions
 func (s *ListsService) Subscriptions(params *ListsSubscriptionsParams) (*Subscribed, *http.Response, error) {
+	return s.SubscriptionsWithContext(context.Background(), params)
+}
+
+// SubscriptionsWithContext is like Subscriptions but uses the given context for the request.
+func (s *ListsService) SubscriptionsWithContext(ctx context.Context, params *ListsSubscriptionsParams) (*Subscribed, *http.Response, error) {
 	subscribed := new(Subscribed)
 	apiError := new(APIError)
-	resp, err := s.sling.New().Get("subscriptions.json").QueryStruct(params).Receive(subscribed, apiError)
-	return subscribed, resp, relevantError(err, *apiError)
+	resp, err := receive(ctx, s.sling.New().Get("subscriptions.json").QueryStruct(params), subscribed, apiError)
+	return subscribed, resp, relevantError(resp, err, *apiError)
+}
+
+// SubscriptionsPaginator returns a Paginator over the lists returned by
+// Subscriptions, fetching pages as needed.
+func (s *ListsService) SubscriptionsPaginator(ctx context.Context, params *ListsSubscriptionsParams) *Paginator[List] {
+	p := ListsSubscriptionsParams{}
+	if params != nil {
+		p = *params
+	}
+	return NewCursorPaginator(ctx, func(ctx context.Context, cursor int64) ([]List, int64, *http.Response, error) {
+		p.Cursor = cursor
+		page, resp, err := s.SubscriptionsWithContext(ctx, &p)
+		return page.Lists, page.NextCursor, resp, err
+	})
 }
 
 // ListsCreateParams are the parameters for ListsService.Create
@@ -281,154 +418,199 @@ type ListsCreateParams struct {
 // Create creates a new list for the authenticated user.
 // https://developer.twitter.com/en/docs/accounts-and-users/create-manage-lists/api-reference/post-lists-create
 func (s *ListsService) Create(name string, params *ListsCreateParams) (*List, *http.Response, error) {
+	return s.CreateWithContext(context.Background(), name, params)
+}
+
+// CreateWithContext is like Create but uses the given context for the request.
+func (s *ListsService) CreateWithContext(ctx context.Context, name string, params *ListsCreateParams) (*List, *http.Response, error) {
 	if params == nil {
 		params = &ListsCreateParams{}
 	}
 	params.Name = name
 	list := new(List)
 	apiError := new(APIError)
-	resp, err := s.sling.New().Post("create.json").BodyForm(params).Receive(list, apiError)
-	return list, resp, relevantError(err, *apiError)
+	resp, err := receive(ctx, s.sling.New().Post("create.json").BodyForm(params), list, apiError)
+	return list, resp, relevantError(resp, err, *apiError)
 
 }
 
 // ListsDestroyParams are the parameters for ListsService.Destroy
 type ListsDestroyParams struct {
 	OwnerScreenName string `url:"owner_screen_name,omitempty"`
-	OwnerID         int64  `url:"owner_id,omitempty"`
-	ListID          int64  `url:"list_id,omitempty"`
+	OwnerID         UserID `url:"owner_id,omitempty"`
+	ListID          ListID `url:"list_id,omitempty"`
 	Slug            string `url:"slug,omitempty"`
 }
 
 // Destroy deletes the specified list.
 // https://developer.twitter.com/en/docs/accounts-and-users/create-manage-lists/api-reference/post-lists-destroy
 func (s *ListsService) Destroy(params *ListsDestroyParams) (*List, *http.Response, error) {
+	return s.DestroyWithContext(context.Background(), params)
+}
+
+// DestroyWithContext is like Destroy but uses the given context for the request.
+func (s *ListsService) DestroyWithContext(ctx context.Context, params *ListsDestroyParams) (*List, *http.Response, error) {
 	list := new(List)
 	apiError := new(APIError)
-	resp, err := s.sling.New().Post("destroy.json").BodyForm(params).Receive(list, apiError)
-	return list, resp, relevantError(err, *apiError)
+	resp, err := receive(ctx, s.sling.New().Post("destroy.json").BodyForm(params), list, apiError)
+	return list, resp, relevantError(resp, err, *apiError)
 }
 
 // ListsMembersCreateParams are the parameters for ListsService.MembersCreate
 type ListsMembersCreateParams struct {
-	ListID          int64  `url:"list_id,omitempty"`
+	ListID          ListID `url:"list_id,omitempty"`
 	Slug            string `url:"slug,omitempty"`
-	UserID          int64  `url:"user_id,omitempty"`
+	UserID          UserID `url:"user_id,omitempty"`
 	ScreenName      string `url:"screen_name,omitempty"`
 	OwnerScreenName string `url:"owner_screen_name,omitempty"`
-	OwnerID         int64  `url:"owner_id,omitempty"`
+	OwnerID         UserID `url:"owner_id,omitempty"`
 }
 
 // MembersCreate adds a member to a list.
 // https://developer.twitter.com/en/docs/accounts-and-users/create-manage-lists/api-reference/post-lists-members-create
 func (s *ListsService) MembersCreate(params *ListsMembersCreateParams) (*http.Response, error) {
+	return s.MembersCreateWithContext(context.Background(), params)
+}
+
+// MembersCreateWithContext is like MembersCreate but uses the given context for the request.
+func (s *ListsService) MembersCreateWithContext(ctx context.Context, params *ListsMembersCreateParams) (*http.Response, error) {
 	apiError := new(APIError)
-	resp, err := s.sling.New().Post("members/create.json").BodyForm(params).Receive(nil, apiError)
-	return resp, err
+	resp, err := receive(ctx, s.sling.New().Post("members/create.json").BodyForm(params), nil, apiError)
+	return resp, relevantError(resp, err, *apiError)
 }
 
 // ListsMembersCreateAllParams are the parameters for ListsService.MembersCreateAll
 type ListsMembersCreateAllParams struct {
-	ListID          int64  `url:"list_id,omitempty"`
+	ListID          ListID `url:"list_id,omitempty"`
 	Slug            string `url:"slug,omitempty"`
-	UserID          string `url:"user_id,omitempty"`
+	UserID          UserID `url:"user_id,omitempty"`
 	ScreenName      string `url:"screen_name,omitempty"`
 	OwnerScreenName string `url:"owner_screen_name,omitempty"`
-	OwnerID         int64  `url:"owner_id,omitempty"`
+	OwnerID         UserID `url:"owner_id,omitempty"`
 }
 
 // MembersCreateAll adds multiple members to a list.
 // https://developer.twitter.com/en/docs/accounts-and-users/create-manage-lists/api-reference/post-lists-members-create_all
 func (s *ListsService) MembersCreateAll(params *ListsMembersCreateAllParams) (*http.Response, error) {
+	return s.MembersCreateAllWithContext(context.Background(), params)
+}
+
+// MembersCreateAllWithContext is like MembersCreateAll but uses the given context for the request.
+func (s *ListsService) MembersCreateAllWithContext(ctx context.Context, params *ListsMembersCreateAllParams) (*http.Response, error) {
 	apiError := new(APIError)
-	resp, err := s.sling.New().Post("members/create_all.json").BodyForm(params).Receive(nil, apiError)
-	return resp, err
+	resp, err := receive(ctx, s.sling.New().Post("members/create_all.json").BodyForm(params), nil, apiError)
+	return resp, relevantError(resp, err, *apiError)
 }
 
 // ListsMembersDestroyParams are the parameters for ListsService.MembersDestroy
 type ListsMembersDestroyParams struct {
-	ListID          int64  `url:"list_id,omitempty"`
+	ListID          ListID `url:"list_id,omitempty"`
 	Slug            string `url:"slug,omitempty"`
-	UserID          int64  `url:"user_id,omitempty"`
+	UserID          UserID `url:"user_id,omitempty"`
 	ScreenName      string `url:"screen_name,omitempty"`
 	OwnerScreenName string `url:"owner_screen_name,omitempty"`
-	OwnerID         int64  `url:"owner_id,omitempty"`
+	OwnerID         UserID `url:"owner_id,omitempty"`
 }
 
 // MembersDestroy removes the specified member from the list.
 // https://developer.twitter.com/en/docs/accounts-and-users/create-manage-lists/api-reference/post-lists-members-destroy
 func (s *ListsService) MembersDestroy(params *ListsMembersDestroyParams) (*http.Response, error) {
+	return s.MembersDestroyWithContext(context.Background(), params)
+}
+
+// MembersDestroyWithContext is like MembersDestroy but uses the given context for the request.
+func (s *ListsService) MembersDestroyWithContext(ctx context.Context, params *ListsMembersDestroyParams) (*http.Response, error) {
 	apiError := new(APIError)
-	resp, err := s.sling.New().Post("members/destroy.json").BodyForm(params).Receive(nil, apiError)
-	return resp, err
+	resp, err := receive(ctx, s.sling.New().Post("members/destroy.json").BodyForm(params), nil, apiError)
+	return resp, relevantError(resp, err, *apiError)
 }
 
 // ListsMembersDestroyAllParams are the parameters for ListsService.MembersDestroyAll
 type ListsMembersDestroyAllParams struct {
-	ListID          int64  `url:"list_id,omitempty"`
+	ListID          ListID `url:"list_id,omitempty"`
 	Slug            string `url:"slug,omitempty"`
-	UserID          string `url:"user_id,omitempty"`
+	UserID          UserID `url:"user_id,omitempty"`
 	ScreenName      string `url:"screen_name,omitempty"`
 	OwnerScreenName string `url:"owner_screen_name,omitempty"`
-	OwnerID         int64  `url:"owner_id,omitempty"`
+	OwnerID         UserID `url:"owner_id,omitempty"`
 }
 
 // MembersDestroyAll removes multiple members from a list.
 // https://developer.twitter.com/en/docs/accounts-and-users/create-manage-lists/api-reference/post-lists-members-destroy_all
 func (s *ListsService) MembersDestroyAll(params *ListsMembersDestroyAllParams) (*http.Response, error) {
+	return s.MembersDestroyAllWithContext(context.Background(), params)
+}
+
+// MembersDestroyAllWithContext is like MembersDestroyAll but uses the given context for the request.
+func (s *ListsService) MembersDestroyAllWithContext(ctx context.Context, params *ListsMembersDestroyAllParams) (*http.Response, error) {
 	apiError := new(APIError)
-	resp, err := s.sling.New().Post("members/destroy_all.json").BodyForm(params).Receive(nil, apiError)
-	return resp, err
+	resp, err := receive(ctx, s.sling.New().Post("members/destroy_all.json").BodyForm(params), nil, apiError)
+	return resp, relevantError(resp, err, *apiError)
 }
 
 // ListsSubscribersCreateParams are the parameters for ListsService.SubscribersCreate
 type ListsSubscribersCreateParams struct {
 	OwnerScreenName string `url:"owner_screen_name,omitempty"`
-	OwnerID         int64  `url:"owner_id,omitempty"`
-	ListID          int64  `url:"list_id,omitempty"`
+	OwnerID         UserID `url:"owner_id,omitempty"`
+	ListID          ListID `url:"list_id,omitempty"`
 	Slug            string `url:"slug,omitempty"`
 }
 
 // SubscribersCreate subscribes the authenticated user to the specified list.
 // https://developer.twitter.com/en/docs/accounts-and-users/create-manage-lists/api-reference/post-lists-subscribers-create
 func (s *ListsService) SubscribersCreate(params *ListsSubscribersCreateParams) (*List, *http.Response, error) {
+	return s.SubscribersCreateWithContext(context.Background(), params)
+}
+
+// SubscribersCreateWithContext is like SubscribersCreate but uses the given context for the request.
+func (s *ListsService) SubscribersCreateWithContext(ctx context.Context, params *ListsSubscribersCreateParams) (*List, *http.Response, error) {
 	list := new(List)
 	apiError := new(APIError)
-	resp, err := s.sling.New().Post("subscribers/create.json").BodyForm(params).Receive(list, apiError)
-	return list, resp, err
+	resp, err := receive(ctx, s.sling.New().Post("subscribers/create.json").BodyForm(params), list, apiError)
+	return list, resp, relevantError(resp, err, *apiError)
 }
 
 // ListsSubscribersDestroyParams are the parameters for ListsService.SubscribersDestroy
 type ListsSubscribersDestroyParams struct {
-	ListID          int64  `url:"list_id,omitempty"`
+	ListID          ListID `url:"list_id,omitempty"`
 	Slug            string `url:"slug,omitempty"`
 	OwnerScreenName string `url:"owner_screen_name,omitempty"`
-	OwnerID         int64  `url:"owner_id,omitempty"`
+	OwnerID         UserID `url:"owner_id,omitempty"`
 }
 
 // SubscribersDestroy unsubscribes the authenticated user from the specified list.
 // https://developer.twitter.com/en/docs/accounts-and-users/create-manage-lists/api-reference/post-lists-subscribers-destroy
 func (s *ListsService) SubscribersDestroy(params *ListsSubscribersDestroyParams) (*http.Response, error) {
+	return s.SubscribersDestroyWithContext(context.Background(), params)
+}
+
+// SubscribersDestroyWithContext is like SubscribersDestroy but uses the given context for the request.
+func (s *ListsService) SubscribersDestroyWithContext(ctx context.Context, params *ListsSubscribersDestroyParams) (*http.Response, error) {
 	apiError := new(APIError)
-	resp, err := s.sling.New().Post("subscribers/destroy.json").BodyForm(params).Receive(nil, apiError)
-	return resp, err
+	resp, err := receive(ctx, s.sling.New().Post("subscribers/destroy.json").BodyForm(params), nil, apiError)
+	return resp, relevantError(resp, err, *apiError)
 }
 
 // ListsUpdateParams are the parameters for ListsService.Update
 type ListsUpdateParams struct {
-	ListID          int64  `url:"list_id,omitempty"`
+	ListID          ListID `url:"list_id,omitempty"`
 	Slug            string `url:"slug,omitempty"`
 	Name            string `url:"name,omitempty"`
 	Mode            string `url:"mode,omitempty"`
 	Description     string `url:"description,omitempty"`
 	OwnerScreenName string `url:"owner_screen_name,omitempty"`
-	OwnerID         int64  `url:"owner_id,omitempty"`
+	OwnerID         UserID `url:"owner_id,omitempty"`
 }
 
 // Update updates the specified list.
 // https://developer.twitter.com/en/docs/accounts-and-users/create-manage-lists/api-reference/post-lists-update
 func (s *ListsService) Update(params *ListsUpdateParams) (*http.Response, error) {
+	return s.UpdateWithContext(context.Background(), params)
+}
+
+// UpdateWithContext is like Update but uses the given context for the request.
+func (s *ListsService) UpdateWithContext(ctx context.Context, params *ListsUpdateParams) (*http.Response, error) {
 	apiError := new(APIError)
-	resp, err := s.sling.New().Post("update.json").BodyForm(params).Receive(nil, apiError)
-	return resp, err
+	resp, err := receive(ctx, s.sling.New().Post("update.json").BodyForm(params), nil, apiError)
+	return resp, relevantError(resp, err, *apiError)
 }
@@ -0,0 +1,392 @@
+package twitter
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/dghubble/sling"
+)
+
+const (
+	// defaultMediaChunkSize is the size of the chunks Upload appends when
+	// MediaUploadParams.ChunkSize is not set.
+	defaultMediaChunkSize = 1 << 20
+	// maxMediaChunkSize is the largest chunk Twitter accepts.
+	maxMediaChunkSize = 5 << 20
+)
+
+// Media processing states reported in MediaProcessingInfo.
+const (
+	MediaProcessingPending    = "pending"
+	MediaProcessingInProgress = "in_progress"
+	MediaProcessingFailed     = "failed"
+	MediaProcessingSucceeded  = "succeeded"
+)
+
+// MediaService provides methods for uploading media to attach to Tweets and
+// Direct Messages.
+type MediaService struct {
+	sling *sling.Sling
+}
+
+// newMediaService returns a new MediaService.
+func newMediaService(sling *sling.Sling) *MediaService {
+	return &MediaService{
+		sling: sling.Path("media/"),
+	}
+}
+
+// MediaUpload is an uploaded media item. Attach it to a Tweet by its
+// MediaID before it expires.
+// https://developer.twitter.com/en/docs/twitter-api/v1/media/upload-media/api-reference/post-media-upload
+type MediaUpload struct {
+	MediaID          MediaID              `json:"media_id"`
+	MediaKey         string               `json:"media_key"`
+	Size             int64                `json:"size"`
+	ExpiresAfterSecs int                  `json:"expires_after_secs"`
+	Image            *MediaUploadImage    `json:"image"`
+	Video            *MediaUploadVideo    `json:"video"`
+	ProcessingInfo   *MediaProcessingInfo `json:"processing_info"`
+}
+
+// MediaUploadImage describes an uploaded image.
+type MediaUploadImage struct {
+	ImageType string `json:"image_type"`
+	Width     int    `json:"w"`
+	Height    int    `json:"h"`
+}
+
+// MediaUploadVideo describes an uploaded video.
+type MediaUploadVideo struct {
+	VideoType string `json:"video_type"`
+}
+
+// MediaProcessingInfo describes the asynchronous processing of uploaded
+// video and GIFs. The media may not be attached until State is
+// MediaProcessingSucceeded.
+type MediaProcessingInfo struct {
+	State           string                `json:"state"`
+	CheckAfterSecs  int                   `json:"check_after_secs"`
+	ProgressPercent int                   `json:"progress_percent"`
+	Error           *MediaProcessingError `json:"error"`
+}
+
+// MediaProcessingError describes why Twitter failed to process uploaded
+// media.
+type MediaProcessingError struct {
+	Code    int    `json:"code"`
+	Name    string `json:"name"`
+	Message string `json:"message"`
+}
+
+func (e *MediaProcessingError) Error() string {
+	return fmt.Sprintf("twitter: media processing failed: %d %v: %v", e.Code, e.Name, e.Message)
+}
+
+// MediaUploadProgress reports the progress of an upload to the Progress
+// callback of MediaUploadParams.
+type MediaUploadProgress struct {
+	// BytesSent and TotalBytes count the bytes of media uploaded so far and
+	// in total.
+	BytesSent  int64
+	TotalBytes int64
+	// ProcessingInfo is set while Twitter processes the uploaded media.
+	ProcessingInfo *MediaProcessingInfo
+}
+
+// MediaUploadParams are the parameters for MediaService.Upload and
+// MediaService.UploadSimple.
+type MediaUploadParams struct {
+	// MediaType is the MIME type of the media (e.g. "video/mp4"). Required
+	// by Upload.
+	MediaType string
+	// MediaCategory is the use of the media (e.g. "tweet_image",
+	// "tweet_gif", "tweet_video", "dm_image"). Video and GIFs must set a
+	// category to be processed.
+	MediaCategory string
+	// AdditionalOwners lists the ids of other users who may use the media.
+	AdditionalOwners []UserID
+	// AltText is the alternative text of images and GIFs, for visually
+	// impaired users. Up to 1000 characters.
+	AltText string
+	// ChunkSize is the size of the chunks Upload appends, up to 5MB.
+	// Defaults to 1MB.
+	ChunkSize int
+	// Progress, if set, is called after each chunk is appended and each time
+	// processing is checked.
+	Progress func(MediaUploadProgress)
+}
+
+// progress calls the Progress callback, if any.
+func (p *MediaUploadParams) progress(progress MediaUploadProgress) {
+	if p.Progress != nil {
+		p.Progress(progress)
+	}
+}
+
+// mediaCommand is the form body of a chunked upload command.
+type mediaCommand struct {
+	Command          string   `url:"command"`
+	MediaID          MediaID  `url:"media_id,omitempty"`
+	TotalBytes       int64    `url:"total_bytes,omitempty"`
+	MediaType        string   `url:"media_type,omitempty"`
+	MediaCategory    string   `url:"media_category,omitempty"`
+	AdditionalOwners []UserID `url:"additional_owners,omitempty,comma"`
+}
+
+// UploadSimple uploads an image in a single request. Use Upload for video,
+// GIFs, and images over 5MB.
+// Requires a user auth context.
+// https://developer.twitter.com/en/docs/twitter-api/v1/media/upload-media/api-reference/post-media-upload
+func (s *MediaService) UploadSimple(data []byte, params *MediaUploadParams) (*MediaUpload, *http.Response, error) {
+	return s.UploadSimpleWithContext(context.Background(), data, params)
+}
+
+// UploadSimpleWithContext is like UploadSimple but uses the given context for the requests.
+func (s *MediaService) UploadSimpleWithContext(ctx context.Context, data []byte, params *MediaUploadParams) (*MediaUpload, *http.Response, error) {
+	if params == nil {
+		params = &MediaUploadParams{}
+	}
+	body := &struct {
+		MediaData        string   `url:"media_data"`
+		MediaCategory    string   `url:"media_category,omitempty"`
+		AdditionalOwners []UserID `url:"additional_owners,omitempty,comma"`
+	}{base64.StdEncoding.EncodeToString(data), params.MediaCategory, params.AdditionalOwners}
+	media := new(MediaUpload)
+	apiError := new(APIError)
+	resp, err := receive(ctx, s.sling.New().Post("upload.json").BodyForm(body), media, apiError)
+	if err := relevantError(resp, err, *apiError); err != nil {
+		return media, resp, err
+	}
+	size := int64(len(data))
+	params.progress(MediaUploadProgress{BytesSent: size, TotalBytes: size})
+	return s.finish(ctx, media, resp, params)
+}
+
+// Upload uploads media from r in chunks, then waits for Twitter to process
+// it, if needed. If r is not an io.Seeker or does not have a Len method,
+// such as a pipe or network stream, it is first spooled to a temporary file
+// to learn its length.
+// Requires a user auth context.
+// https://developer.twitter.com/en/docs/twitter-api/v1/media/upload-media/uploading-media/chunked-media-upload
+func (s *MediaService) Upload(r io.Reader, params *MediaUploadParams) (*MediaUpload, *http.Response, error) {
+	return s.UploadWithContext(context.Background(), r, params)
+}
+
+// UploadWithContext is like Upload but uses the given context for the requests.
+func (s *MediaService) UploadWithContext(ctx context.Context, r io.Reader, params *MediaUploadParams) (*MediaUpload, *http.Response, error) {
+	if params == nil {
+		params = &MediaUploadParams{}
+	}
+	if params.MediaType == "" {
+		return nil, nil, errors.New("twitter: media upload requires a MediaType")
+	}
+	chunkSize := params.ChunkSize
+	if chunkSize <= 0 {
+		chunkSize = defaultMediaChunkSize
+	}
+	if chunkSize > maxMediaChunkSize {
+		chunkSize = maxMediaChunkSize
+	}
+	r, total, cleanup, err := mediaSource(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer cleanup()
+
+	media := new(MediaUpload)
+	apiError := new(APIError)
+	start := &mediaCommand{
+		Command:          "INIT",
+		TotalBytes:       total,
+		MediaType:        params.MediaType,
+		MediaCategory:    params.MediaCategory,
+		AdditionalOwners: params.AdditionalOwners,
+	}
+	resp, err := receive(ctx, s.sling.New().Post("upload.json").BodyForm(start), media, apiError)
+	if err := relevantError(resp, err, *apiError); err != nil {
+		return media, resp, err
+	}
+
+	chunk := make([]byte, chunkSize)
+	var sent int64
+	for segment := 0; sent < total; segment++ {
+		if remaining := total - sent; remaining < int64(len(chunk)) {
+			chunk = chunk[:remaining]
+		}
+		n, err := io.ReadFull(r, chunk)
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			if n == 0 {
+				return media, resp, fmt.Errorf("twitter: media ended after %d of %d bytes", sent, total)
+			}
+		} else if err != nil {
+			return media, resp, err
+		}
+		if resp, err = s.append(ctx, media.MediaID, segment, chunk[:n]); err != nil {
+			return media, resp, err
+		}
+		sent += int64(n)
+		params.progress(MediaUploadProgress{BytesSent: sent, TotalBytes: total})
+	}
+
+	finalize := &mediaCommand{Command: "FINALIZE", MediaID: media.MediaID}
+	*apiError = APIError{}
+	resp, err = receive(ctx, s.sling.New().Post("upload.json").BodyForm(finalize), media, apiError)
+	if err := relevantError(resp, err, *apiError); err != nil {
+		return media, resp, err
+	}
+	return s.finish(ctx, media, resp, params)
+}
+
+// append uploads a chunk of media as the segment with the given index.
+func (s *MediaService) append(ctx context.Context, mediaID MediaID, segment int, chunk []byte) (*http.Response, error) {
+	body := new(bytes.Buffer)
+	w := multipart.NewWriter(body)
+	w.WriteField("command", "APPEND")
+	w.WriteField("media_id", mediaID.String())
+	w.WriteField("segment_index", strconv.Itoa(segment))
+	part, err := w.CreateFormFile("media", "media")
+	if err != nil {
+		return nil, err
+	}
+	part.Write(chunk)
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	apiError := new(APIError)
+	// a bytes.Buffer body may be replayed if the request is retried
+	req := s.sling.New().Post("upload.json").Set("Content-Type", w.FormDataContentType()).Body(body)
+	resp, err := receive(ctx, req, nil, apiError)
+	return resp, relevantError(resp, err, *apiError)
+}
+
+// minProcessingCheckWait is the least time waited between media processing
+// status checks.
+const minProcessingCheckWait = time.Second
+
+// finish waits for uploaded media to be processed, then sets its alt text,
+// if any.
+func (s *MediaService) finish(ctx context.Context, media *MediaUpload, resp *http.Response, params *MediaUploadParams) (*MediaUpload, *http.Response, error) {
+	for media.ProcessingInfo != nil {
+		info := media.ProcessingInfo
+		params.progress(MediaUploadProgress{BytesSent: media.Size, TotalBytes: media.Size, ProcessingInfo: info})
+		if info.State == MediaProcessingFailed {
+			if info.Error != nil {
+				return media, resp, info.Error
+			}
+			return media, resp, &MediaProcessingError{Name: "Failed", Message: "unknown error"}
+		}
+		if info.State != MediaProcessingPending && info.State != MediaProcessingInProgress {
+			break
+		}
+		wait := time.Duration(info.CheckAfterSecs) * time.Second
+		if wait < minProcessingCheckWait {
+			// don't poll without waiting if Twitter omits check_after_secs
+			wait = minProcessingCheckWait
+		}
+		sleepOrDone(wait, ctx.Done())
+		if err := ctx.Err(); err != nil {
+			return media, resp, err
+		}
+		status, statusResp, err := s.StatusWithContext(ctx, media.MediaID)
+		if err != nil {
+			return media, statusResp, err
+		}
+		// STATUS omits the size, which progress reports
+		status.Size = media.Size
+		media, resp = status, statusResp
+	}
+	if params.AltText != "" {
+		if metaResp, err := s.CreateMetadataWithContext(ctx, media.MediaID, params.AltText); err != nil {
+			return media, metaResp, err
+		}
+	}
+	return media, resp, nil
+}
+
+// Status returns the upload with the given media id, reporting the progress
+// of its processing.
+// Requires a user auth context.
+// https://developer.twitter.com/en/docs/twitter-api/v1/media/upload-media/api-reference/get-media-upload-status
+func (s *MediaService) Status(mediaID MediaID) (*MediaUpload, *http.Response, error) {
+	return s.StatusWithContext(context.Background(), mediaID)
+}
+
+// StatusWithContext is like Status but uses the given context for the request.
+func (s *MediaService) StatusWithContext(ctx context.Context, mediaID MediaID) (*MediaUpload, *http.Response, error) {
+	query := &mediaCommand{Command: "STATUS", MediaID: mediaID}
+	media := new(MediaUpload)
+	apiError := new(APIError)
+	resp, err := receive(ctx, s.sling.New().Get("upload.json").QueryStruct(query), media, apiError)
+	return media, resp, relevantError(resp, err, *apiError)
+}
+
+// CreateMetadata sets the alt text of the uploaded image or GIF with the
+// given media id.
+// Requires a user auth context.
+// https://developer.twitter.com/en/docs/twitter-api/v1/media/upload-media/api-reference/post-media-metadata-create
+func (s *MediaService) CreateMetadata(mediaID MediaID, altText string) (*http.Response, error) {
+	return s.CreateMetadataWithContext(context.Background(), mediaID, altText)
+}
+
+// CreateMetadataWithContext is like CreateMetadata but uses the given context for the request.
+func (s *MediaService) CreateMetadataWithContext(ctx context.Context, mediaID MediaID, altText string) (*http.Response, error) {
+	body := &struct {
+		MediaID MediaID `json:"media_id"`
+		AltText struct {
+			Text string `json:"text"`
+		} `json:"alt_text"`
+	}{MediaID: mediaID}
+	body.AltText.Text = altText
+	apiError := new(APIError)
+	resp, err := receive(ctx, s.sling.New().Post("metadata/create.json").BodyJSON(body), nil, apiError)
+	return resp, relevantError(resp, err, *apiError)
+}
+
+// mediaSource returns a reader of the media in r and its remaining length,
+// spooling r to a temporary file if its length can't otherwise be known.
+// The returned cleanup function removes any temporary file.
+func mediaSource(r io.Reader) (io.Reader, int64, func(), error) {
+	noop := func() {}
+	if seeker, ok := r.(io.Seeker); ok {
+		// seeking fails for pipes and other unseekable files
+		if current, err := seeker.Seek(0, io.SeekCurrent); err == nil {
+			if end, err := seeker.Seek(0, io.SeekEnd); err == nil {
+				if _, err := seeker.Seek(current, io.SeekStart); err != nil {
+					return nil, 0, noop, err
+				}
+				return r, end - current, noop, nil
+			}
+		}
+	}
+	if lener, ok := r.(interface{ Len() int }); ok {
+		return r, int64(lener.Len()), noop, nil
+	}
+
+	spool, err := os.CreateTemp("", "go-twitter-media-*")
+	if err != nil {
+		return nil, 0, noop, err
+	}
+	cleanup := func() {
+		spool.Close()
+		os.Remove(spool.Name())
+	}
+	total, err := io.Copy(spool, r)
+	if err == nil {
+		_, err = spool.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		cleanup()
+		return nil, 0, noop, err
+	}
+	return spool, total, cleanup, nil
+}
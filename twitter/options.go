@@ -0,0 +1,79 @@
+package twitter
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Option configures a Client created by NewClient.
+type Option func(*clientOptions)
+
+// clientOptions holds the settings Options apply to a Client.
+type clientOptions struct {
+	baseURL     string
+	uploadURL   string
+	header      http.Header
+	retryPolicy *RetryPolicy
+}
+
+// newClientOptions returns the default client settings with the given
+// Options applied in order.
+func newClientOptions(opts []Option) *clientOptions {
+	o := &clientOptions{
+		baseURL:   twitterAPI,
+		uploadURL: uploadAPI,
+		header:    make(http.Header),
+	}
+	o.header.Set("User-Agent", userAgent)
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// WithBaseURL sets the base URL API requests are made against, such as a
+// local stand-in server or a proxy. Defaults to the Twitter v2 API.
+func WithBaseURL(baseURL string) Option {
+	return func(o *clientOptions) {
+		// service paths are resolved relative to the base URL, which drops
+		// the last path segment unless it ends in a slash
+		if !strings.HasSuffix(baseURL, "/") {
+			baseURL += "/"
+		}
+		o.baseURL = baseURL
+	}
+}
+
+// WithUploadURL sets the base URL media upload requests are made against.
+// Defaults to the Twitter v1.1 upload API.
+func WithUploadURL(uploadURL string) Option {
+	return func(o *clientOptions) {
+		if !strings.HasSuffix(uploadURL, "/") {
+			uploadURL += "/"
+		}
+		o.uploadURL = uploadURL
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) Option {
+	return func(o *clientOptions) {
+		o.header.Set("User-Agent", userAgent)
+	}
+}
+
+// WithHeader sets a header sent with every request, replacing any value
+// set by a previous Option.
+func WithHeader(key, value string) Option {
+	return func(o *clientOptions) {
+		o.header.Set(key, value)
+	}
+}
+
+// WithBearerToken authorizes every request with the given OAuth2 bearer
+// token (application auth). User auth is instead provided by the
+// http.Client given to NewClient.
+func WithBearerToken(bearerToken string) Option {
+	return WithHeader("Authorization", fmt.Sprintf("Bearer %s", bearerToken))
+}
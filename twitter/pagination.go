@@ -0,0 +1,143 @@
+package twitter
+
+import (
+	"context"
+	"net/http"
+)
+
+// Paginator iterates over the items of a paginated endpoint, fetching the
+// next page from Twitter when the items of the current page are used up.
+//
+//	paginator := client.Followers.IDsPaginator(ctx, &twitter.FollowerIDParams{ScreenName: "golang"})
+//	for paginator.Next() {
+//		id := paginator.Value()
+//	}
+//	if err := paginator.Err(); err != nil {
+//		// handle error
+//	}
+//
+// Set MaxPages or MaxItems before the first call to Next to limit how many
+// pages are fetched or items are returned.
+type Paginator[T any] struct {
+	// MaxPages is the maximum number of pages to fetch. Zero means no limit.
+	MaxPages int
+	// MaxItems is the maximum number of items to return. Zero means no limit.
+	MaxItems int
+
+	ctx   context.Context
+	fetch func(ctx context.Context) ([]T, bool, *http.Response, error)
+	page  []T
+	value T
+	more  bool
+	resp  *http.Response
+	err   error
+	pages int
+	items int
+}
+
+// newPaginator returns a Paginator which fetches pages with the given
+// function. fetch returns the items of the next page and whether further
+// pages follow it.
+func newPaginator[T any](ctx context.Context, fetch func(ctx context.Context) ([]T, bool, *http.Response, error)) *Paginator[T] {
+	return &Paginator[T]{
+		ctx:   ctx,
+		fetch: fetch,
+		more:  true,
+	}
+}
+
+// Next advances to the next item, fetching the next page if needed. Returns
+// false when there are no more items, a limit is reached, or an error
+// occurred.
+func (p *Paginator[T]) Next() bool {
+	if p.err != nil || (p.MaxItems > 0 && p.items >= p.MaxItems) {
+		return false
+	}
+	for len(p.page) == 0 {
+		if !p.more || (p.MaxPages > 0 && p.pages >= p.MaxPages) {
+			return false
+		}
+		page, more, resp, err := p.fetch(p.ctx)
+		p.pages++
+		p.resp = resp
+		if err != nil {
+			p.err = err
+			return false
+		}
+		p.page, p.more = page, more
+	}
+	p.value, p.page = p.page[0], p.page[1:]
+	p.items++
+	return true
+}
+
+// Value returns the current item.
+func (p *Paginator[T]) Value() T {
+	return p.value
+}
+
+// Err returns the error which stopped pagination, if any.
+func (p *Paginator[T]) Err() error {
+	return p.err
+}
+
+// Response returns the response of the last page fetched.
+func (p *Paginator[T]) Response() *http.Response {
+	return p.resp
+}
+
+// NewCursorPaginator returns a Paginator for endpoints which page with int64
+// cursors, such as v1.1 followers/ids. fetch returns the items for the given
+// cursor and the next cursor, which is 0 after the last page. The first
+// cursor is -1.
+func NewCursorPaginator[T any](ctx context.Context, fetch func(ctx context.Context, cursor int64) ([]T, int64, *http.Response, error)) *Paginator[T] {
+	cursor := int64(-1)
+	return newPaginator(ctx, func(ctx context.Context) ([]T, bool, *http.Response, error) {
+		items, next, resp, err := fetch(ctx, cursor)
+		more := next != 0 && next != cursor
+		cursor = next
+		return items, more, resp, err
+	})
+}
+
+// NewTokenPaginator returns a Paginator for endpoints which page with string
+// tokens, such as v2 pagination_token and next_token or premium search
+// next. fetch returns the items for the given token and the next token,
+// which is empty after the last page. The first token is empty.
+func NewTokenPaginator[T any](ctx context.Context, fetch func(ctx context.Context, token string) ([]T, string, *http.Response, error)) *Paginator[T] {
+	var token string
+	return newPaginator(ctx, func(ctx context.Context) ([]T, bool, *http.Response, error) {
+		items, next, resp, err := fetch(ctx, token)
+		more := next != "" && next != token
+		token = next
+		return items, more, resp, err
+	})
+}
+
+// NewMaxIDPaginator returns a Paginator for endpoints which page backwards
+// through a since_id/max_id window, such as v1.1 timelines and search. fetch
+// returns the items with IDs at most maxID (no bound when maxID is 0) and id
+// returns the ID of an item. Pagination ends at the first empty page.
+func NewMaxIDPaginator[T any](ctx context.Context, fetch func(ctx context.Context, maxID TweetID) ([]T, *http.Response, error), id func(T) TweetID) *Paginator[T] {
+	var maxID TweetID
+	return newPaginator(ctx, func(ctx context.Context) ([]T, bool, *http.Response, error) {
+		items, resp, err := fetch(ctx, maxID)
+		if err != nil || len(items) == 0 {
+			return items, false, resp, err
+		}
+		lowest := id(items[0])
+		for _, item := range items[1:] {
+			if itemID := id(item); itemID < lowest {
+				lowest = itemID
+			}
+		}
+		// max_id is inclusive, so continue below the lowest ID seen
+		maxID = lowest - 1
+		return items, maxID > 0, resp, nil
+	})
+}
+
+// tweetID returns the ID of the Tweet for max_id pagination.
+func tweetID(tweet Tweet) TweetID {
+	return tweet.ID
+}
@@ -0,0 +1,167 @@
+package twitter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// collect returns the values of the paginator until Next returns false.
+func collect[T any](paginator *Paginator[T]) []T {
+	var values []T
+	for paginator.Next() {
+		values = append(values, paginator.Value())
+	}
+	return values
+}
+
+func TestCursorPaginator(t *testing.T) {
+	pages := map[int64]struct {
+		items []int
+		next  int64
+	}{
+		-1: {[]int{1, 2}, 11},
+		11: {nil, 12},
+		12: {[]int{3}, 0},
+	}
+	var cursors []int64
+	paginator := NewCursorPaginator(context.Background(), func(ctx context.Context, cursor int64) ([]int, int64, *http.Response, error) {
+		cursors = append(cursors, cursor)
+		page := pages[cursor]
+		return page.items, page.next, nil, nil
+	})
+	// empty pages are skipped until the cursor is 0
+	assert.Equal(t, []int{1, 2, 3}, collect(paginator))
+	assert.Equal(t, []int64{-1, 11, 12}, cursors)
+	assert.Nil(t, paginator.Err())
+	assert.False(t, paginator.Next())
+}
+
+func TestCursorPaginator_repeatedCursor(t *testing.T) {
+	calls := 0
+	paginator := NewCursorPaginator(context.Background(), func(ctx context.Context, cursor int64) ([]int, int64, *http.Response, error) {
+		calls++
+		return []int{calls}, 5, nil, nil
+	})
+	// a cursor which doesn't advance ends pagination rather than looping
+	assert.Equal(t, []int{1, 2}, collect(paginator))
+}
+
+func TestTokenPaginator(t *testing.T) {
+	pages := map[string]struct {
+		items []string
+		next  string
+	}{
+		"":   {[]string{"a", "b"}, "t1"},
+		"t1": {[]string{"c"}, "t2"},
+		"t2": {[]string{"d"}, ""},
+	}
+	var tokens []string
+	paginator := NewTokenPaginator(context.Background(), func(ctx context.Context, token string) ([]string, string, *http.Response, error) {
+		tokens = append(tokens, token)
+		page := pages[token]
+		return page.items, page.next, nil, nil
+	})
+	assert.Equal(t, []string{"a", "b", "c", "d"}, collect(paginator))
+	assert.Equal(t, []string{"", "t1", "t2"}, tokens)
+	assert.Nil(t, paginator.Err())
+}
+
+func TestTokenPaginator_limits(t *testing.T) {
+	fetch := func(ctx context.Context, token string) ([]int, string, *http.Response, error) {
+		return []int{1, 2, 3}, token + "x", nil, nil
+	}
+	paginator := NewTokenPaginator(context.Background(), fetch)
+	paginator.MaxPages = 2
+	assert.Equal(t, []int{1, 2, 3, 1, 2, 3}, collect(paginator))
+
+	paginator = NewTokenPaginator(context.Background(), fetch)
+	paginator.MaxItems = 4
+	assert.Equal(t, []int{1, 2, 3, 1}, collect(paginator))
+}
+
+func TestTokenPaginator_error(t *testing.T) {
+	errPage := errors.New("page failed")
+	paginator := NewTokenPaginator(context.Background(), func(ctx context.Context, token string) ([]int, string, *http.Response, error) {
+		if token == "" {
+			return []int{1}, "next", nil, nil
+		}
+		return nil, "", nil, errPage
+	})
+	assert.Equal(t, []int{1}, collect(paginator))
+	assert.Equal(t, errPage, paginator.Err())
+	assert.False(t, paginator.Next())
+}
+
+func TestMaxIDPaginator(t *testing.T) {
+	var maxIDs []TweetID
+	paginator := NewMaxIDPaginator(context.Background(), func(ctx context.Context, maxID TweetID) ([]TweetID, *http.Response, error) {
+		maxIDs = append(maxIDs, maxID)
+		switch maxID {
+		case 0:
+			return []TweetID{30, 20, 25}, nil, nil
+		case 19:
+			return []TweetID{10}, nil, nil
+		}
+		return nil, nil, nil
+	}, func(id TweetID) TweetID {
+		return id
+	})
+	// max_id continues below the lowest ID until an empty page
+	assert.Equal(t, []TweetID{30, 20, 25, 10}, collect(paginator))
+	assert.Equal(t, []TweetID{0, 19, 9}, maxIDs)
+}
+
+func TestFollowerService_IDsPaginator(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/2/followers/ids.json", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Query().Get("cursor") {
+		case "-1":
+			assertQuery(t, map[string]string{"screen_name": "golang", "cursor": "-1"}, r)
+			fmt.Fprintf(w, `{"ids": [1, 2], "next_cursor": 1516933260114270762}`)
+		case "1516933260114270762":
+			fmt.Fprintf(w, `{"ids": [3], "next_cursor": 0}`)
+		default:
+			t.Errorf("unexpected cursor %s", r.URL.Query().Get("cursor"))
+		}
+	})
+
+	client := NewClient(httpClient)
+	paginator := client.Followers.IDsPaginator(context.Background(), &FollowerIDParams{ScreenName: "golang"})
+	assert.Equal(t, []UserID{1, 2, 3}, collect(paginator))
+	assert.Nil(t, paginator.Err())
+	assert.Equal(t, 200, paginator.Response().StatusCode)
+}
+
+func TestTimelineService_UserTweetsPaginator(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/2/users/12/tweets", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Query().Get("pagination_token") {
+		case "":
+			fmt.Fprintf(w, `{"data": [{"id": "20", "text": "a"}], "meta": {"result_count": 1, "next_token": "7140dibdnow9c7btw3w29grvxfcgvpb9n9coehpk7xz5i"}}`)
+		case "7140dibdnow9c7btw3w29grvxfcgvpb9n9coehpk7xz5i":
+			fmt.Fprintf(w, `{"data": [{"id": "19", "text": "b"}], "meta": {"result_count": 1}}`)
+		}
+	})
+
+	client := NewClient(httpClient)
+	paginator := client.Timelines.UserTweetsPaginator(context.Background(), 12, nil)
+	var ids []TweetID
+	for paginator.Next() {
+		ids = append(ids, paginator.Value().ID)
+	}
+	assert.Nil(t, paginator.Err())
+	assert.Equal(t, []TweetID{20, 19}, ids)
+}
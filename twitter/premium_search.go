@@ -1,6 +1,7 @@
 package twitter
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
@@ -78,39 +79,87 @@ type PremiumSearchCountTweetParams struct {
 // SearchFullArchive returns a collection of Tweets matching a search query from tweets back to the very first tweet.
 // https://developer.twitter.com/en/docs/tweets/search/api-reference/premium-search
 func (s *PremiumSearchService) SearchFullArchive(params *PremiumSearchTweetParams, label string) (*PremiumSearch, *http.Response, error) {
+	return s.SearchFullArchiveWithContext(context.Background(), params, label)
+}
+
+// SearchFullArchiveWithContext is like SearchFullArchive but uses the given context for the request.
+func (s *PremiumSearchService) SearchFullArchiveWithContext(ctx context.Context, params *PremiumSearchTweetParams, label string) (*PremiumSearch, *http.Response, error) {
 	search := new(PremiumSearch)
 	apiError := new(APIError)
 	path := fmt.Sprintf("fullarchive/%s.json", label)
-	resp, err := s.sling.New().Get(path).QueryStruct(params).Receive(search, apiError)
-	return search, resp, relevantError(err, *apiError)
+	resp, err := receive(ctx, s.sling.New().Get(path).QueryStruct(params), search, apiError)
+	return search, resp, relevantError(resp, err, *apiError)
+}
+
+// SearchFullArchivePaginator returns a Paginator over the Tweets returned by
+// SearchFullArchive, fetching pages with next as needed.
+func (s *PremiumSearchService) SearchFullArchivePaginator(ctx context.Context, params *PremiumSearchTweetParams, label string) *Paginator[Tweet] {
+	p := PremiumSearchTweetParams{}
+	if params != nil {
+		p = *params
+	}
+	return NewTokenPaginator(ctx, func(ctx context.Context, next string) ([]Tweet, string, *http.Response, error) {
+		p.Next = next
+		page, resp, err := s.SearchFullArchiveWithContext(ctx, &p, label)
+		return page.Results, page.Next, resp, err
+	})
 }
 
 // Search30Days returns a collection of Tweets matching a search query from Tweets posted within the last 30 days.
 // https://developer.twitter.com/en/docs/tweets/search/api-reference/premium-search
 func (s *PremiumSearchService) Search30Days(params *PremiumSearchTweetParams, label string) (*PremiumSearch, *http.Response, error) {
+	return s.Search30DaysWithContext(context.Background(), params, label)
+}
+
+// Search30DaysWithContext is like Search30Days but uses the given context for the request.
+func (s *PremiumSearchService) Search30DaysWithContext(ctx context.Context, params *PremiumSearchTweetParams, label string) (*PremiumSearch, *http.Response, error) {
 	search := new(PremiumSearch)
 	apiError := new(APIError)
 	path := fmt.Sprintf("30day/%s.json", label)
-	resp, err := s.sling.New().Get(path).QueryStruct(params).Receive(search, apiError)
-	return search, resp, relevantError(err, *apiError)
+	resp, err := receive(ctx, s.sling.New().Get(path).QueryStruct(params), search, apiError)
+	return search, resp, relevantError(resp, err, *apiError)
+}
+
+// Search30DaysPaginator returns a Paginator over the Tweets returned by
+// Search30Days, fetching pages with next as needed.
+func (s *PremiumSearchService) Search30DaysPaginator(ctx context.Context, params *PremiumSearchTweetParams, label string) *Paginator[Tweet] {
+	p := PremiumSearchTweetParams{}
+	if params != nil {
+		p = *params
+	}
+	return NewTokenPaginator(ctx, func(ctx context.Context, next string) ([]Tweet, string, *http.Response, error) {
+		p.Next = next
+		page, resp, err := s.Search30DaysWithContext(ctx, &p, label)
+		return page.Results, page.Next, resp, err
+	})
 }
 
 // CountFullArchive returns a counts of Tweets matching a search query from tweets back to the very first tweet.
 // https://developer.twitter.com/en/docs/tweets/search/api-reference/premium-search#CountsEndpoint
 func (s *PremiumSearchService) CountFullArchive(params *PremiumSearchCountTweetParams, label string) (*PremiumSearchCount, *http.Response, error) {
+	return s.CountFullArchiveWithContext(context.Background(), params, label)
+}
+
+// CountFullArchiveWithContext is like CountFullArchive but uses the given context for the request.
+func (s *PremiumSearchService) CountFullArchiveWithContext(ctx context.Context, params *PremiumSearchCountTweetParams, label string) (*PremiumSearchCount, *http.Response, error) {
 	counts := new(PremiumSearchCount)
 	apiError := new(APIError)
 	path := fmt.Sprintf("fullarchive/%s/counts.json", label)
-	resp, err := s.sling.New().Get(path).QueryStruct(params).Receive(counts, apiError)
-	return counts, resp, relevantError(err, *apiError)
+	resp, err := receive(ctx, s.sling.New().Get(path).QueryStruct(params), counts, apiError)
+	return counts, resp, relevantError(resp, err, *apiError)
 }
 
 // Count30Days returns a counts of Tweets matching a search query from Tweets posted within the last 30 days.
 // https://developer.twitter.com/en/docs/tweets/search/api-reference/premium-search#CountsEndpoint
 func (s *PremiumSearchService) Count30Days(params *PremiumSearchCountTweetParams, label string) (*PremiumSearchCount, *http.Response, error) {
+	return s.Count30DaysWithContext(context.Background(), params, label)
+}
+
+// Count30DaysWithContext is like Count30Days but uses the given context for the request.
+func (s *PremiumSearchService) Count30DaysWithContext(ctx context.Context, params *PremiumSearchCountTweetParams, label string) (*PremiumSearchCount, *http.Response, error) {
 	counts := new(PremiumSearchCount)
 	apiError := new(APIError)
 	path := fmt.Sprintf("30day/%s/counts.json", label)
-	resp, err := s.sling.New().Get(path).QueryStruct(params).Receive(counts, apiError)
-	return counts, resp, relevantError(err, *apiError)
+	resp, err := receive(ctx, s.sling.New().Get(path).QueryStruct(params), counts, apiError)
+	return counts, resp, relevantError(resp, err, *apiError)
 }
@@ -1,7 +1,13 @@
 package twitter
 
 import (
+	"context"
+	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/dghubble/sling"
 )
@@ -61,8 +67,117 @@ type RateLimitParams struct {
 // Status summarizes the current rate limits of specified resource families.
 // https://developer.twitter.com/en/docs/developer-utilities/rate-limit-status/api-reference/get-application-rate_limit_status
 func (s *RateLimitService) Status(params *RateLimitParams) (*RateLimit, *http.Response, error) {
+	return s.StatusWithContext(context.Background(), params)
+}
+
+// StatusWithContext is like Status but uses the given context for the request.
+func (s *RateLimitService) StatusWithContext(ctx context.Context, params *RateLimitParams) (*RateLimit, *http.Response, error) {
 	rateLimit := new(RateLimit)
 	apiError := new(APIError)
-	resp, err := s.sling.New().Get("rate_limit_status.json").QueryStruct(params).Receive(rateLimit, apiError)
-	return rateLimit, resp, relevantError(err, *apiError)
+	resp, err := receive(ctx, s.sling.New().Get("rate_limit_status.json").QueryStruct(params), rateLimit, apiError)
+	return rateLimit, resp, relevantError(resp, err, *apiError)
+}
+
+// Rate is the rate limit state of an endpoint as reported by the
+// x-rate-limit-limit, x-rate-limit-remaining and x-rate-limit-reset headers
+// of its most recent response.
+// https://developer.twitter.com/en/docs/twitter-api/rate-limits
+type Rate struct {
+	Limit     int
+	Remaining int
+	Reset     time.Time
+}
+
+// parseRate returns the Rate reported by the response headers and true, or
+// false if the headers are absent or malformed.
+func parseRate(header http.Header) (Rate, bool) {
+	limit, err := strconv.Atoi(header.Get("x-rate-limit-limit"))
+	if err != nil {
+		return Rate{}, false
+	}
+	remaining, err := strconv.Atoi(header.Get("x-rate-limit-remaining"))
+	if err != nil {
+		return Rate{}, false
+	}
+	reset, err := strconv.ParseInt(header.Get("x-rate-limit-reset"), 10, 64)
+	if err != nil {
+		return Rate{}, false
+	}
+	return Rate{Limit: limit, Remaining: remaining, Reset: time.Unix(reset, 0)}, true
+}
+
+// rateLimitTracker is a sling.Doer which records the Rate reported by every
+// response, keyed by endpoint.
+type rateLimitTracker struct {
+	doer  sling.Doer
+	mu    sync.Mutex
+	rates map[string]Rate
+}
+
+// newRateLimitTracker returns a rateLimitTracker which sends requests with
+// the given Doer.
+func newRateLimitTracker(doer sling.Doer) *rateLimitTracker {
+	return &rateLimitTracker{
+		doer:  doer,
+		rates: make(map[string]Rate),
+	}
+}
+
+// Do sends the request and records the Rate of the response, if any.
+func (t *rateLimitTracker) Do(req *http.Request) (*http.Response, error) {
+	resp, err := t.doer.Do(req)
+	if err != nil {
+		return resp, err
+	}
+	if rate, ok := parseRate(resp.Header); ok {
+		t.mu.Lock()
+		t.rates[endpoint(req.Method, req.URL.Path)] = rate
+		t.mu.Unlock()
+	}
+	return resp, err
+}
+
+// rate returns the last Rate recorded for the endpoint.
+func (t *rateLimitTracker) rate(method, path string) (Rate, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	rate, ok := t.rates[endpoint(method, path)]
+	return rate, ok
+}
+
+// snapshot returns a copy of the recorded Rates, keyed by endpoint.
+func (t *rateLimitTracker) snapshot() map[string]Rate {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	rates := make(map[string]Rate, len(t.rates))
+	for key, rate := range t.rates {
+		rates[key] = rate
+	}
+	return rates
+}
+
+// endpoint returns the rate limit key for a request method and URL path.
+// Twitter limits endpoints rather than resources, so numeric IDs and
+// usernames in the path are replaced by placeholders, giving keys like
+// "GET /2/tweets/:id", "GET /2/users/by/username/:username", and
+// "POST /1.1/statuses/destroy/:id.json". The API version is kept as is.
+func endpoint(method, path string) string {
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	segments := strings.Split(path, "/")
+	// segments[0] is empty and segments[1] is the API version
+	for i := 2; i < len(segments); i++ {
+		id, ext := segments[i], ""
+		if dot := strings.Index(id, "."); dot >= 0 {
+			id, ext = id[:dot], id[dot:]
+		}
+		switch {
+		case segments[i-1] == "username":
+			segments[i] = ":username"
+		case id != "" && strings.Trim(id, "0123456789") == "":
+			segments[i] = ":id" + ext
+		}
+	}
+	return fmt.Sprintf("%s %s", strings.ToUpper(method), strings.Join(segments, "/"))
 }
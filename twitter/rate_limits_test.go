@@ -0,0 +1,73 @@
+package twitter
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func rateLimitHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-rate-limit-limit", "900")
+	w.Header().Set("x-rate-limit-remaining", "899")
+	w.Header().Set("x-rate-limit-reset", "1700000000")
+	fmt.Fprintf(w, `{"data": {"id": "20", "text": "just setting up my twttr"}}`)
+}
+
+func TestRates_v2(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/2/tweets/20", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		rateLimitHandler(w, r)
+	})
+
+	client := NewClient(httpClient)
+	_, _, err := client.Tweets.LookupByID(20, nil)
+	assert.Nil(t, err)
+	expected := Rate{Limit: 900, Remaining: 899, Reset: time.Unix(1700000000, 0)}
+	assert.Equal(t, map[string]Rate{"GET /2/tweets/:id": expected}, client.Rates())
+	rate, ok := client.Rate("GET", "/2/tweets/21")
+	assert.True(t, ok)
+	assert.Equal(t, expected, rate)
+}
+
+func TestRates_v1(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/1.1/statuses/destroy/20.json", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		rateLimitHandler(w, r)
+	})
+
+	client := NewClient(httpClient, WithBaseURL("https://api.twitter.com/1.1/"))
+	_, _, err := client.Statuses.Destroy(20, nil)
+	assert.Nil(t, err)
+	expected := Rate{Limit: 900, Remaining: 899, Reset: time.Unix(1700000000, 0)}
+	assert.Equal(t, map[string]Rate{"POST /1.1/statuses/destroy/:id.json": expected}, client.Rates())
+}
+
+func TestEndpoint(t *testing.T) {
+	cases := []struct {
+		method   string
+		path     string
+		expected string
+	}{
+		{"GET", "/2/tweets/20", "GET /2/tweets/:id"},
+		{"get", "2/tweets", "GET /2/tweets"},
+		{"POST", "/2/tweets", "POST /2/tweets"},
+		{"GET", "/2/users/12/tweets", "GET /2/users/:id/tweets"},
+		{"GET", "/2/users/by/username/jack", "GET /2/users/by/username/:username"},
+		{"GET", "/2/users/by/username/2020", "GET /2/users/by/username/:username"},
+		{"POST", "/1.1/statuses/destroy/20.json", "POST /1.1/statuses/destroy/:id.json"},
+		{"GET", "/1.1/statuses/show.json", "GET /1.1/statuses/show.json"},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.expected, endpoint(c.method, c.path))
+	}
+}
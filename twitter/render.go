@@ -0,0 +1,265 @@
+package twitter
+
+import (
+	"fmt"
+	"html"
+	"net/url"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/carbonrook/go-twitter/text"
+)
+
+// EntityOffsets is the unit the Start and End offsets of Entities count.
+type EntityOffsets int
+
+const (
+	// CodePointOffsets count Unicode code points, as v2 Tweets do.
+	CodePointOffsets EntityOffsets = iota
+	// UTF16Offsets count UTF-16 code units, as JavaScript strings and some
+	// v1.1 payloads do, so characters outside the Basic Multilingual Plane
+	// (e.g. most emoji) count as 2.
+	UTF16Offsets
+)
+
+// Renderer renders the text of Tweets with their Entities as linked HTML,
+// Markdown, or plain text with t.co links expanded. The zero value renders
+// v2 Tweets, linking to twitter.com.
+type Renderer struct {
+	// Offsets is the unit of the Entities' offsets.
+	Offsets EntityOffsets
+	// HashtagURL, MentionURL, and CashtagURL return the link of a hashtag,
+	// username, or cashtag. They default to twitter.com search and profiles.
+	HashtagURL func(tag string) string
+	MentionURL func(username string) string
+	CashtagURL func(tag string) string
+}
+
+// span is an entity located in the text by byte offsets.
+type span struct {
+	start, end int
+	// prefix is the symbol the entity text starts with, if any
+	prefix string
+	// value is the tag or username, or the URL entity
+	value string
+	url   *URLEntity
+}
+
+// HTML returns the text as HTML, linking the entities. The text is escaped,
+// whether or not Twitter escaped it.
+func (r Renderer) HTML(content string, entities *Entities) string {
+	return r.render(content, entities, func(s string) string {
+		return html.EscapeString(html.UnescapeString(s))
+	}, func(href, display string) string {
+		return fmt.Sprintf(`<a href="%s">%s</a>`, html.EscapeString(href), html.EscapeString(display))
+	})
+}
+
+// markdownEscaper escapes the characters of text which Markdown would
+// otherwise read as emphasis, code, links, headings, or HTML.
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`, "`", "\\`", `*`, `\*`, `_`, `\_`, `[`, `\[`, `]`, `\]`,
+	`<`, `\<`, `>`, `\>`, `#`, `\#`, `~`, `\~`, `|`, `\|`,
+)
+
+// Markdown returns the text as Markdown, linking the entities. The text is
+// escaped, so Markdown syntax in it (e.g. *, _, or backticks) renders as
+// written.
+func (r Renderer) Markdown(content string, entities *Entities) string {
+	return r.render(content, entities, func(s string) string {
+		return markdownEscaper.Replace(html.UnescapeString(s))
+	}, func(href, display string) string {
+		return fmt.Sprintf("[%s](%s)", markdownEscaper.Replace(html.UnescapeString(display)), strings.ReplaceAll(href, ")", "%29"))
+	})
+}
+
+// PlainText returns the text with its t.co links replaced by the URLs
+// they link to, and the escaping Twitter applies to text undone.
+func (r Renderer) PlainText(content string, entities *Entities) string {
+	return r.render(content, entities, html.UnescapeString, nil)
+}
+
+// render renders the text between entities with plain and the links of
+// entities with link. If link is nil, hashtags, mentions, and cashtags are
+// rendered as plain text and URLs are replaced by their destination.
+func (r Renderer) render(content string, entities *Entities, plain func(string) string, link func(href, display string) string) string {
+	var b strings.Builder
+	last := 0
+	for _, s := range r.spans(content, entities) {
+		b.WriteString(plain(content[last:s.start]))
+		last = s.end
+		if s.url != nil {
+			if link == nil {
+				b.WriteString(firstNonEmpty(s.url.UnwoundURL, s.url.ExpandedURL, s.url.URL, content[s.start:s.end]))
+				continue
+			}
+			href := firstNonEmpty(s.url.ExpandedURL, s.url.URL, content[s.start:s.end])
+			b.WriteString(link(href, firstNonEmpty(s.url.DisplayURL, href)))
+			continue
+		}
+		if link == nil {
+			b.WriteString(plain(content[s.start:s.end]))
+			continue
+		}
+		b.WriteString(link(r.href(s), content[s.start:s.end]))
+	}
+	b.WriteString(plain(content[last:]))
+	return b.String()
+}
+
+// href returns the link of a hashtag, mention, or cashtag.
+func (r Renderer) href(s span) string {
+	switch s.prefix {
+	case "@":
+		if r.MentionURL != nil {
+			return r.MentionURL(s.value)
+		}
+		return "https://twitter.com/" + s.value
+	case "$":
+		if r.CashtagURL != nil {
+			return r.CashtagURL(s.value)
+		}
+		return "https://twitter.com/search?q=" + url.QueryEscape("$"+s.value)
+	default:
+		if r.HashtagURL != nil {
+			return r.HashtagURL(s.value)
+		}
+		return "https://twitter.com/hashtag/" + url.PathEscape(s.value)
+	}
+}
+
+// spans returns the entities located in the text, ordered by position.
+// Entities whose offsets fall outside the text or split a character, or
+// which don't match the text at their offsets, are dropped.
+func (r Renderer) spans(content string, entities *Entities) []span {
+	if entities == nil {
+		return nil
+	}
+	offsets := r.byteOffsets(content)
+	locate := func(start, end int64) (int, int, bool) {
+		if start < 0 || end < start || end >= int64(len(offsets)) {
+			return 0, 0, false
+		}
+		s, e := offsets[start], offsets[end]
+		return s, e, s >= 0 && e >= 0
+	}
+	var spans []span
+	tag := func(start, end int64, prefixes, value string) {
+		s, e, ok := locate(start, end)
+		if !ok {
+			return
+		}
+		symbol, size := utf8.DecodeRuneInString(content[s:e])
+		// Twitter normalizes the full width symbols (e.g. ＃) to ASCII
+		if !strings.ContainsRune(prefixes, symbol) || !strings.EqualFold(content[s+size:e], value) {
+			return
+		}
+		spans = append(spans, span{start: s, end: e, prefix: prefixes[:1], value: value})
+	}
+	for _, hashtag := range entities.Hashtags {
+		tag(hashtag.Start, hashtag.End, "#＃", hashtag.Tag)
+	}
+	for _, mention := range entities.UserMentions {
+		tag(mention.Start, mention.End, "@＠", mention.Username)
+	}
+	for _, cashtag := range entities.Cashtags {
+		tag(cashtag.Start, cashtag.End, "$", cashtag.Tag)
+	}
+	for i := range entities.Urls {
+		u := &entities.Urls[i]
+		if s, e, ok := locate(u.Start, u.End); ok && (u.URL == "" || content[s:e] == u.URL) {
+			spans = append(spans, span{start: s, end: e, url: u})
+		}
+	}
+	sort.Slice(spans, func(i, j int) bool {
+		return spans[i].start < spans[j].start
+	})
+	// drop overlapping entities, keeping the first
+	kept := spans[:0]
+	for _, s := range spans {
+		if len(kept) == 0 || s.start >= kept[len(kept)-1].end {
+			kept = append(kept, s)
+		}
+	}
+	return kept
+}
+
+// byteOffsets maps each offset of the Renderer's unit in the text to its
+// byte offset, or -1 for offsets which split a character.
+func (r Renderer) byteOffsets(content string) []int {
+	var offsets []int
+	for i, c := range content {
+		offsets = append(offsets, i)
+		if r.Offsets == UTF16Offsets && c > 0xFFFF {
+			// the low surrogate of a pair is not a character boundary
+			offsets = append(offsets, -1)
+		}
+	}
+	return append(offsets, len(content))
+}
+
+// firstNonEmpty returns the first of the values which is not empty.
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// HTML returns the Tweet's text as HTML, linking its entities.
+func (t Tweet) HTML() string {
+	return Renderer{}.HTML(t.Text, &t.Entities)
+}
+
+// Markdown returns the Tweet's text as Markdown, linking its entities.
+func (t Tweet) Markdown() string {
+	return Renderer{}.Markdown(t.Text, &t.Entities)
+}
+
+// PlainText returns the Tweet's text with its t.co links replaced by the
+// URLs they link to.
+func (t Tweet) PlainText() string {
+	return Renderer{}.PlainText(t.Text, &t.Entities)
+}
+
+// EntitiesFromText extracts the hashtags, mentions, cashtags, and URLs of
+// text which has not been posted, such as a draft, with code point offsets
+// for a Renderer.
+func EntitiesFromText(content string) *Entities {
+	entities := new(Entities)
+	// convert byte offsets to code point offsets
+	offsets := make(map[int]int64)
+	n := int64(0)
+	for i := range content {
+		offsets[i] = n
+		n++
+	}
+	offsets[len(content)] = n
+	for _, entity := range text.Extract(content) {
+		start, end := offsets[entity.Start], offsets[entity.End]
+		switch entity.Type {
+		case text.HashtagEntity:
+			entities.Hashtags = append(entities.Hashtags, TagEntity{Start: start, End: end, Tag: entity.Value})
+		case text.MentionEntity:
+			entities.UserMentions = append(entities.UserMentions, MentionEntity{Start: start, End: end, Username: entity.Value})
+		case text.CashtagEntity:
+			entities.Cashtags = append(entities.Cashtags, TagEntity{Start: start, End: end, Tag: entity.Value})
+		case text.URLEntity:
+			expanded := entity.Value
+			if !strings.Contains(expanded, "://") {
+				expanded = "http://" + expanded
+			}
+			entities.Urls = append(entities.Urls, URLEntity{
+				Start:       start,
+				End:         end,
+				URL:         entity.Value,
+				ExpandedURL: expanded,
+				DisplayURL:  entity.Value,
+			})
+		}
+	}
+	return entities
+}
@@ -0,0 +1,38 @@
+package twitter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderer_Markdown(t *testing.T) {
+	cases := []struct {
+		content  string
+		expected string
+	}{
+		{"plain text", "plain text"},
+		{"*not bold* _not italic_ `not code`", "\\*not bold\\* \\_not italic\\_ \\`not code\\`"},
+		{"[not](a link) &lt;b&gt; a &amp; b", "\\[not\\](a link) \\<b\\> a & b"},
+		{"# not a heading\n> not a quote", "\\# not a heading\n\\> not a quote"},
+		{`back\slash ~strike~ a|b`, `back\\slash \~strike\~ a\|b`},
+		{"hi @gopher_dev", "hi [@gopher\\_dev](https://twitter.com/gopher_dev)"},
+		{"see example.com/a_(b)", "see [example.com/a\\_(b)](http://example.com/a_(b%29)"},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.expected, Renderer{}.Markdown(c.content, EntitiesFromText(c.content)), c.content)
+	}
+}
+
+func TestRenderer_HTML(t *testing.T) {
+	content := "*hi* @gopher &lt;3 #golang"
+	assert.Equal(t,
+		`*hi* <a href="https://twitter.com/gopher">@gopher</a> &lt;3 <a href="https://twitter.com/hashtag/golang">#golang</a>`,
+		Renderer{}.HTML(content, EntitiesFromText(content)))
+}
+
+func TestRenderer_PlainText(t *testing.T) {
+	content := "*hi* &amp; https://t.co/abc"
+	entities := &Entities{Urls: []URLEntity{{Start: 11, End: 27, URL: "https://t.co/abc", ExpandedURL: "https://go.dev"}}}
+	assert.Equal(t, "*hi* & https://go.dev", Renderer{}.PlainText(content, entities))
+}
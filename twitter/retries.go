@@ -0,0 +1,174 @@
+package twitter
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/dghubble/sling"
+)
+
+var errNoGetBody = errors.New("twitter: request body cannot be replayed")
+
+// RetryPolicy configures automatic retries of REST requests which fail with
+// a 429 Too Many Requests or a 500, 502, 503 or 504 server error response.
+//
+// Requests which may not be idempotent (POST and PATCH) are only retried
+// after a 429, which Twitter returns before acting on the request, unless
+// RetryNonIdempotent is set.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts made for a request,
+	// including the first. Defaults to 3.
+	MaxAttempts int
+	// WaitOnRateLimit waits until the x-rate-limit-reset time of a 429
+	// response before retrying, rather than backing off exponentially.
+	WaitOnRateLimit bool
+	// MaxWait is the longest single wait before a retry. If a retry would
+	// require waiting longer, the failed response is returned instead.
+	// Zero means no limit.
+	MaxWait time.Duration
+	// RetryNonIdempotent also retries POST and PATCH requests after server
+	// errors, which may repeat the action (e.g. post a Tweet twice).
+	RetryNonIdempotent bool
+	// OnRetry, if set, is called before waiting to retry a request.
+	OnRetry func(RetryEvent)
+}
+
+// RetryEvent describes a failed attempt which is about to be retried.
+type RetryEvent struct {
+	// Request is the request being retried.
+	Request *http.Request
+	// StatusCode is the response status of the failed attempt.
+	StatusCode int
+	// Attempt is the number of the failed attempt, starting at 1.
+	Attempt int
+	// Wait is the time until the next attempt.
+	Wait time.Duration
+}
+
+// WithRetryPolicy enables automatic retries of failed REST requests
+// according to the given RetryPolicy. By default, requests are not retried.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(o *clientOptions) {
+		if policy.MaxAttempts <= 0 {
+			policy.MaxAttempts = 3
+		}
+		o.retryPolicy = &policy
+	}
+}
+
+// retrier is a sling.Doer which retries requests according to a RetryPolicy,
+// backing off exponentially between attempts.
+type retrier struct {
+	doer   sling.Doer
+	policy RetryPolicy
+	// backOff returns the exponential backoff for the retries of a request
+	backOff func() backoff.BackOff
+}
+
+// newRetrier returns a retrier which sends requests with the given Doer.
+func newRetrier(doer sling.Doer, policy RetryPolicy) *retrier {
+	return &retrier{
+		doer:   doer,
+		policy: policy,
+		backOff: func() backoff.BackOff {
+			return newExponentialBackOff()
+		},
+	}
+}
+
+// Do sends the request, retrying it while the policy allows. Returns the
+// response of the last attempt.
+func (r *retrier) Do(req *http.Request) (*http.Response, error) {
+	expBackOff := r.backOff()
+	for attempt := 1; ; attempt++ {
+		resp, err := r.doer.Do(req)
+		if err != nil || attempt >= r.policy.MaxAttempts || !r.retryable(req, resp) {
+			return resp, err
+		}
+		wait := r.wait(resp, expBackOff)
+		if wait == backoff.Stop || (r.policy.MaxWait > 0 && wait > r.policy.MaxWait) {
+			return resp, err
+		}
+		next, err := rewind(req)
+		if err != nil {
+			// the request body cannot be sent again
+			return resp, nil
+		}
+		// discard the failed response so its connection may be reused
+		io.Copy(ioutil.Discard, resp.Body)
+		resp.Body.Close()
+
+		if r.policy.OnRetry != nil {
+			r.policy.OnRetry(RetryEvent{
+				Request:    req,
+				StatusCode: resp.StatusCode,
+				Attempt:    attempt,
+				Wait:       wait,
+			})
+		}
+		sleepOrDone(wait, req.Context().Done())
+		if err := req.Context().Err(); err != nil {
+			return nil, err
+		}
+		req = next
+	}
+}
+
+// retryable returns true if the policy allows retrying the request after
+// the given response.
+func (r *retrier) retryable(req *http.Request, resp *http.Response) bool {
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return idempotent(req.Method) || r.policy.RetryNonIdempotent
+	}
+	return false
+}
+
+// wait returns the time to wait before retrying after the given response.
+func (r *retrier) wait(resp *http.Response, expBackOff backoff.BackOff) time.Duration {
+	if resp.StatusCode == http.StatusTooManyRequests && r.policy.WaitOnRateLimit {
+		if rate, ok := parseRate(resp.Header); ok {
+			// allow for clock skew between the client and Twitter
+			wait := time.Until(rate.Reset) + time.Second
+			if wait < 0 {
+				wait = 0
+			}
+			return wait
+		}
+	}
+	return expBackOff.NextBackOff()
+}
+
+// rewind returns a copy of the request which can be sent again, or an error
+// if the request body cannot be replayed.
+func rewind(req *http.Request) (*http.Request, error) {
+	next := req.Clone(req.Context())
+	if req.Body == nil || req.Body == http.NoBody {
+		return next, nil
+	}
+	if req.GetBody == nil {
+		return nil, errNoGetBody
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	next.Body = body
+	return next, nil
+}
+
+// idempotent returns true if requests with the given method may safely be
+// repeated.
+func idempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
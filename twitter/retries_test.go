@@ -0,0 +1,187 @@
+package twitter
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+// testRetrier returns a retrier which sends requests with the http.Client
+// and retries without backing off.
+func testRetrier(httpClient *http.Client, policy RetryPolicy) *retrier {
+	options := newClientOptions([]Option{WithRetryPolicy(policy)})
+	r := newRetrier(httpClient, *options.retryPolicy)
+	r.backOff = func() backoff.BackOff {
+		return &backoff.ZeroBackOff{}
+	}
+	return r
+}
+
+// statusSequence returns a handler which responds with the given statuses in
+// order, then 200 OK, counting the requests it receives.
+func statusSequence(calls *int, statuses ...int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		*calls++
+		if *calls <= len(statuses) {
+			w.WriteHeader(statuses[*calls-1])
+			return
+		}
+		fmt.Fprintf(w, `{}`)
+	}
+}
+
+func TestRetrier_retriesServerErrors(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+	calls := 0
+	mux.HandleFunc("/2/tweets", statusSequence(&calls, 503, 500))
+
+	var events []RetryEvent
+	r := testRetrier(httpClient, RetryPolicy{OnRetry: func(event RetryEvent) {
+		events = append(events, event)
+	}})
+	req, _ := http.NewRequest("GET", "https://api.twitter.com/2/tweets", nil)
+	resp, err := r.Do(req)
+	assert.Nil(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Equal(t, 3, calls)
+	if assert.Len(t, events, 2) {
+		assert.Equal(t, 503, events[0].StatusCode)
+		assert.Equal(t, 1, events[0].Attempt)
+		assert.Equal(t, 500, events[1].StatusCode)
+		assert.Equal(t, 2, events[1].Attempt)
+	}
+}
+
+func TestRetrier_maxAttempts(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+	calls := 0
+	mux.HandleFunc("/2/tweets", statusSequence(&calls, 503, 503, 503, 503))
+
+	r := testRetrier(httpClient, RetryPolicy{MaxAttempts: 2})
+	req, _ := http.NewRequest("GET", "https://api.twitter.com/2/tweets", nil)
+	resp, err := r.Do(req)
+	assert.Nil(t, err)
+	assert.Equal(t, 503, resp.StatusCode)
+	assert.Equal(t, 2, calls)
+}
+
+func TestRetrier_doesNotRetryClientErrors(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+	calls := 0
+	mux.HandleFunc("/2/tweets", statusSequence(&calls, 400))
+
+	r := testRetrier(httpClient, RetryPolicy{})
+	req, _ := http.NewRequest("GET", "https://api.twitter.com/2/tweets", nil)
+	resp, err := r.Do(req)
+	assert.Nil(t, err)
+	assert.Equal(t, 400, resp.StatusCode)
+	assert.Equal(t, 1, calls)
+}
+
+func TestRetrier_nonIdempotent(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+	calls := 0
+	var bodies []string
+	mux.HandleFunc("/2/tweets", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		statusSequence(&calls, 503, 429)(w, r)
+	})
+
+	// POSTs are not retried after server errors by default
+	r := testRetrier(httpClient, RetryPolicy{})
+	req, _ := http.NewRequest("POST", "https://api.twitter.com/2/tweets", strings.NewReader(`{"text":"hi"}`))
+	resp, err := r.Do(req)
+	assert.Nil(t, err)
+	assert.Equal(t, 503, resp.StatusCode)
+	assert.Equal(t, 1, calls)
+
+	// but are after 429s, and with RetryNonIdempotent, replaying the body
+	calls, bodies = 0, nil
+	r = testRetrier(httpClient, RetryPolicy{RetryNonIdempotent: true})
+	req, _ = http.NewRequest("POST", "https://api.twitter.com/2/tweets", strings.NewReader(`{"text":"hi"}`))
+	resp, err = r.Do(req)
+	assert.Nil(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Equal(t, 3, calls)
+	assert.Equal(t, []string{`{"text":"hi"}`, `{"text":"hi"}`, `{"text":"hi"}`}, bodies)
+}
+
+func TestRetrier_waitOnRateLimit(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+	calls := 0
+	mux.HandleFunc("/2/tweets", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.Header().Set("x-rate-limit-limit", "900")
+			w.Header().Set("x-rate-limit-remaining", "0")
+			w.Header().Set("x-rate-limit-reset", fmt.Sprint(time.Now().Add(-time.Hour).Unix()))
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		fmt.Fprintf(w, `{}`)
+	})
+
+	var events []RetryEvent
+	client := NewClient(httpClient, WithRetryPolicy(RetryPolicy{
+		WaitOnRateLimit: true,
+		OnRetry: func(event RetryEvent) {
+			events = append(events, event)
+		},
+	}))
+	_, resp, err := client.Tweets.Lookup([]TweetID{20}, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Equal(t, 2, calls)
+	if assert.Len(t, events, 1) {
+		assert.Equal(t, 429, events[0].StatusCode)
+		// the reset time has passed, so the retry is immediate
+		assert.Equal(t, time.Duration(0), events[0].Wait)
+	}
+}
+
+func TestRetrier_maxWait(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+	calls := 0
+	mux.HandleFunc("/2/tweets", statusSequence(&calls, 503))
+
+	// the first exponential backoff is seconds long
+	r := newRetrier(httpClient, RetryPolicy{MaxAttempts: 3, MaxWait: time.Millisecond})
+	req, _ := http.NewRequest("GET", "https://api.twitter.com/2/tweets", nil)
+	resp, err := r.Do(req)
+	assert.Nil(t, err)
+	assert.Equal(t, 503, resp.StatusCode)
+	assert.Equal(t, 1, calls)
+}
+
+func TestRetrier_contextDoneWhileWaiting(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+	calls := 0
+	mux.HandleFunc("/2/tweets", statusSequence(&calls, 503))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r := newRetrier(httpClient, RetryPolicy{MaxAttempts: 3, OnRetry: func(RetryEvent) {
+		cancel()
+	}})
+	req, _ := http.NewRequestWithContext(ctx, "GET", "https://api.twitter.com/2/tweets", nil)
+	start := time.Now()
+	resp, err := r.Do(req)
+	assert.Nil(t, resp)
+	assert.Equal(t, context.Canceled, err)
+	assert.Equal(t, 1, calls)
+	assert.True(t, time.Since(start) < time.Second)
+}
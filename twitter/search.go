@@ -1,7 +1,9 @@
 package twitter
 
 import (
+	"context"
 	"net/http"
+	"time"
 
 	"github.com/dghubble/sling"
 )
@@ -15,9 +17,9 @@ type Search struct {
 // SearchMetadata describes a Search result.
 type SearchMetadata struct {
 	Count       int     `json:"count"`
-	SinceID     int64   `json:"since_id"`
+	SinceID     TweetID `json:"since_id"`
 	SinceIDStr  string  `json:"since_id_str"`
-	MaxID       int64   `json:"max_id"`
+	MaxID       TweetID `json:"max_id"`
 	MaxIDStr    string  `json:"max_id_str"`
 	RefreshURL  string  `json:"refresh_url"`
 	NextResults string  `json:"next_results"`
@@ -25,40 +27,263 @@ type SearchMetadata struct {
 	Query       string  `json:"query"`
 }
 
+// TweetSearch represents the result of a v2 Tweet search.
+type TweetSearch struct {
+	Tweets   []*Tweet         `json:"data"`
+	Includes *Includes        `json:"includes"`
+	Meta     *TweetSearchMeta `json:"meta"`
+	Errors   []ErrorDetail    `json:"errors"`
+}
+
+// TweetSearchMeta describes a TweetSearch result.
+type TweetSearchMeta struct {
+	NewestID    TweetID `json:"newest_id"`
+	OldestID    TweetID `json:"oldest_id"`
+	ResultCount int     `json:"result_count"`
+	NextToken   string  `json:"next_token"`
+}
+
+// TweetCounts represents the result of a v2 Tweet counts request.
+type TweetCounts struct {
+	Counts []TweetCountsBucket `json:"data"`
+	Meta   *TweetCountsMeta    `json:"meta"`
+	Errors []ErrorDetail       `json:"errors"`
+}
+
+// TweetCountsBucket counts the Tweets matching a query between Start and End.
+type TweetCountsBucket struct {
+	Start      Timestamp `json:"start"`
+	End        Timestamp `json:"end"`
+	TweetCount int64     `json:"tweet_count"`
+}
+
+// TweetCountsMeta describes a TweetCounts result.
+type TweetCountsMeta struct {
+	TotalTweetCount int64  `json:"total_tweet_count"`
+	NextToken       string `json:"next_token"`
+}
+
 // SearchService provides methods for accessing Twitter search API endpoints.
 type SearchService struct {
-	sling *sling.Sling
+	baseSling *sling.Sling
+	sling     *sling.Sling
 }
 
 // newSearchService returns a new SearchService.
 func newSearchService(sling *sling.Sling) *SearchService {
 	return &SearchService{
-		sling: sling.Path("search/"),
+		baseSling: sling.New(),
+		sling:     sling.Path("search/"),
 	}
 }
 
 // SearchTweetParams are the parameters for SearchService.Tweets
 type SearchTweetParams struct {
-	Query           string `url:"q,omitempty"`
-	Geocode         string `url:"geocode,omitempty"`
-	Lang            string `url:"lang,omitempty"`
-	Locale          string `url:"locale,omitempty"`
-	ResultType      string `url:"result_type,omitempty"`
-	Count           int    `url:"count,omitempty"`
-	SinceID         int64  `url:"since_id,omitempty"`
-	MaxID           int64  `url:"max_id,omitempty"`
-	Until           string `url:"until,omitempty"`
-	Since           string `url:"since,omitempty"`
-	Filter          string `url:"filter,omitempty"`
-	IncludeEntities *bool  `url:"include_entities,omitempty"`
-	TweetMode       string `url:"tweet_mode,omitempty"`
+	Query           string  `url:"q,omitempty"`
+	Geocode         string  `url:"geocode,omitempty"`
+	Lang            string  `url:"lang,omitempty"`
+	Locale          string  `url:"locale,omitempty"`
+	ResultType      string  `url:"result_type,omitempty"`
+	Count           int     `url:"count,omitempty"`
+	SinceID         TweetID `url:"since_id,omitempty"`
+	MaxID           TweetID `url:"max_id,omitempty"`
+	Until           string  `url:"until,omitempty"`
+	Since           string  `url:"since,omitempty"`
+	Filter          string  `url:"filter,omitempty"`
+	IncludeEntities *bool   `url:"include_entities,omitempty"`
+	TweetMode       string  `url:"tweet_mode,omitempty"`
 }
 
 // Tweets returns a collection of Tweets matching a search query.
 // https://dev.twitter.com/rest/reference/get/search/tweets
 func (s *SearchService) Tweets(params *SearchTweetParams) (*Search, *http.Response, error) {
+	return s.TweetsWithContext(context.Background(), params)
+}
+
+// TweetsWithContext is like Tweets but uses the given context for the request.
+func (s *SearchService) TweetsWithContext(ctx context.Context, params *SearchTweetParams) (*Search, *http.Response, error) {
 	search := new(Search)
 	apiError := new(APIError)
-	resp, err := s.sling.New().Get("tweets.json").QueryStruct(params).Receive(search, apiError)
-	return search, resp, relevantError(err, *apiError)
+	resp, err := receive(ctx, s.sling.New().Get("tweets.json").QueryStruct(params), search, apiError)
+	return search, resp, relevantError(resp, err, *apiError)
+}
+
+// TweetsPaginator returns a Paginator over the Tweets returned by
+// Tweets, fetching older pages with max_id as needed.
+func (s *SearchService) TweetsPaginator(ctx context.Context, params *SearchTweetParams) *Paginator[Tweet] {
+	p := SearchTweetParams{}
+	if params != nil {
+		p = *params
+	}
+	return NewMaxIDPaginator(ctx, func(ctx context.Context, maxID TweetID) ([]Tweet, *http.Response, error) {
+		if maxID != 0 {
+			p.MaxID = maxID
+		}
+		page, resp, err := s.TweetsWithContext(ctx, &p)
+		return page.Statuses, resp, err
+	}, tweetID)
+}
+
+// TweetSearchParams are the parameters for SearchService.Recent and
+// SearchService.All.
+type TweetSearchParams struct {
+	Query      string    `url:"query,omitempty"`
+	StartTime  time.Time `url:"start_time,omitempty"`
+	EndTime    time.Time `url:"end_time,omitempty"`
+	SinceID    TweetID   `url:"since_id,omitempty"`
+	UntilID    TweetID   `url:"until_id,omitempty"`
+	SortOrder  string    `url:"sort_order,omitempty"`
+	MaxResults int       `url:"max_results,omitempty"`
+	NextToken  string    `url:"next_token,omitempty"`
+	FieldSet
+}
+
+// Recent returns Tweets from the last seven days matching the query.
+// https://developer.twitter.com/en/docs/twitter-api/tweets/search/api-reference/get-tweets-search-recent
+func (s *SearchService) Recent(query string, params *TweetSearchParams) (*TweetSearch, *http.Response, error) {
+	return s.RecentWithContext(context.Background(), query, params)
+}
+
+// RecentWithContext is like Recent but uses the given context for the request.
+func (s *SearchService) RecentWithContext(ctx context.Context, query string, params *TweetSearchParams) (*TweetSearch, *http.Response, error) {
+	return s.search(ctx, "tweets/search/recent", query, params)
+}
+
+// RecentPaginator returns a Paginator over the Tweets returned by Recent,
+// fetching pages with next_token as needed.
+func (s *SearchService) RecentPaginator(ctx context.Context, query string, params *TweetSearchParams) *Paginator[*Tweet] {
+	return s.searchPaginator(ctx, "tweets/search/recent", query, params)
+}
+
+// All returns Tweets from the full archive matching the query.
+// Requires Academic Research access.
+// https://developer.twitter.com/en/docs/twitter-api/tweets/search/api-reference/get-tweets-search-all
+func (s *SearchService) All(query string, params *TweetSearchParams) (*TweetSearch, *http.Response, error) {
+	return s.AllWithContext(context.Background(), query, params)
+}
+
+// AllWithContext is like All but uses the given context for the request.
+func (s *SearchService) AllWithContext(ctx context.Context, query string, params *TweetSearchParams) (*TweetSearch, *http.Response, error) {
+	return s.search(ctx, "tweets/search/all", query, params)
+}
+
+// AllPaginator returns a Paginator over the Tweets returned by All,
+// fetching pages with next_token as needed.
+func (s *SearchService) AllPaginator(ctx context.Context, query string, params *TweetSearchParams) *Paginator[*Tweet] {
+	return s.searchPaginator(ctx, "tweets/search/all", query, params)
+}
+
+// search requests the v2 search endpoint at the given path.
+func (s *SearchService) search(ctx context.Context, path, query string, params *TweetSearchParams) (*TweetSearch, *http.Response, error) {
+	if params == nil {
+		params = &TweetSearchParams{}
+	}
+	search := new(TweetSearch)
+	if err := params.ValidateForTweets(); err != nil {
+		return search, nil, err
+	}
+	// set the query on a copy, leaving the caller's params as given
+	p := *params
+	p.Query = query
+	apiError := new(APIError)
+	resp, err := receive(ctx, s.baseSling.New().Get(path).QueryStruct(&p), search, apiError)
+	search.Includes.hydrate(search.Tweets...)
+	return search, resp, relevantError(resp, err, *apiError)
+}
+
+// searchPaginator returns a Paginator over the v2 search endpoint at the
+// given path.
+func (s *SearchService) searchPaginator(ctx context.Context, path, query string, params *TweetSearchParams) *Paginator[*Tweet] {
+	p := TweetSearchParams{}
+	if params != nil {
+		p = *params
+	}
+	return NewTokenPaginator(ctx, func(ctx context.Context, token string) ([]*Tweet, string, *http.Response, error) {
+		p.NextToken = token
+		search, resp, err := s.search(ctx, path, query, &p)
+		if search.Meta == nil {
+			return search.Tweets, "", resp, err
+		}
+		return search.Tweets, search.Meta.NextToken, resp, err
+	})
+}
+
+// TweetCountsParams are the parameters for SearchService.CountsRecent and
+// SearchService.CountsAll.
+type TweetCountsParams struct {
+	Query       string    `url:"query,omitempty"`
+	StartTime   time.Time `url:"start_time,omitempty"`
+	EndTime     time.Time `url:"end_time,omitempty"`
+	SinceID     TweetID   `url:"since_id,omitempty"`
+	UntilID     TweetID   `url:"until_id,omitempty"`
+	Granularity string    `url:"granularity,omitempty"`
+	NextToken   string    `url:"next_token,omitempty"`
+}
+
+// CountsRecent returns counts of Tweets from the last seven days matching
+// the query.
+// https://developer.twitter.com/en/docs/twitter-api/tweets/counts/api-reference/get-tweets-counts-recent
+func (s *SearchService) CountsRecent(query string, params *TweetCountsParams) (*TweetCounts, *http.Response, error) {
+	return s.CountsRecentWithContext(context.Background(), query, params)
+}
+
+// CountsRecentWithContext is like CountsRecent but uses the given context for the request.
+func (s *SearchService) CountsRecentWithContext(ctx context.Context, query string, params *TweetCountsParams) (*TweetCounts, *http.Response, error) {
+	return s.counts(ctx, "tweets/counts/recent", query, params)
+}
+
+// CountsRecentPaginator returns a Paginator over the buckets returned by
+// CountsRecent, fetching pages with next_token as needed.
+func (s *SearchService) CountsRecentPaginator(ctx context.Context, query string, params *TweetCountsParams) *Paginator[TweetCountsBucket] {
+	return s.countsPaginator(ctx, "tweets/counts/recent", query, params)
+}
+
+// CountsAll returns counts of Tweets from the full archive matching the
+// query.
+// Requires Academic Research access.
+// https://developer.twitter.com/en/docs/twitter-api/tweets/counts/api-reference/get-tweets-counts-all
+func (s *SearchService) CountsAll(query string, params *TweetCountsParams) (*TweetCounts, *http.Response, error) {
+	return s.CountsAllWithContext(context.Background(), query, params)
+}
+
+// CountsAllWithContext is like CountsAll but uses the given context for the request.
+func (s *SearchService) CountsAllWithContext(ctx context.Context, query string, params *TweetCountsParams) (*TweetCounts, *http.Response, error) {
+	return s.counts(ctx, "tweets/counts/all", query, params)
+}
+
+// CountsAllPaginator returns a Paginator over the buckets returned by
+// CountsAll, fetching pages with next_token as needed.
+func (s *SearchService) CountsAllPaginator(ctx context.Context, query string, params *TweetCountsParams) *Paginator[TweetCountsBucket] {
+	return s.countsPaginator(ctx, "tweets/counts/all", query, params)
+}
+
+// counts requests the v2 counts endpoint at the given path.
+func (s *SearchService) counts(ctx context.Context, path, query string, params *TweetCountsParams) (*TweetCounts, *http.Response, error) {
+	if params == nil {
+		params = &TweetCountsParams{}
+	}
+	// set the query on a copy, leaving the caller's params as given
+	p := *params
+	p.Query = query
+	counts := new(TweetCounts)
+	apiError := new(APIError)
+	resp, err := receive(ctx, s.baseSling.New().Get(path).QueryStruct(&p), counts, apiError)
+	return counts, resp, relevantError(resp, err, *apiError)
+}
+
+// countsPaginator returns a Paginator over the v2 counts endpoint at the
+// given path.
+func (s *SearchService) countsPaginator(ctx context.Context, path, query string, params *TweetCountsParams) *Paginator[TweetCountsBucket] {
+	p := TweetCountsParams{}
+	if params != nil {
+		p = *params
+	}
+	return NewTokenPaginator(ctx, func(ctx context.Context, token string) ([]TweetCountsBucket, string, *http.Response, error) {
+		p.NextToken = token
+		counts, resp, err := s.counts(ctx, path, query, &p)
+		if counts.Meta == nil {
+			return counts.Counts, "", resp, err
+		}
+		return counts.Counts, counts.Meta.NextToken, resp, err
+	})
 }
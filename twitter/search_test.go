@@ -0,0 +1,52 @@
+package twitter
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchService_Recent(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	var queries []string
+	mux.HandleFunc("/2/tweets/search/recent", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		queries = append(queries, r.URL.Query().Get("query"))
+		assertQuery(t, map[string]string{"query": queries[len(queries)-1], "max_results": "10"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"data": [{"id": "20", "text": "just setting up my twttr"}], "meta": {"result_count": 1}}`)
+	})
+
+	client := NewClient(httpClient)
+	params := &TweetSearchParams{MaxResults: 10}
+	search, _, err := client.Search.Recent("golang", params)
+	assert.Nil(t, err)
+	assert.Equal(t, []*Tweet{{ID: 20, Text: "just setting up my twttr"}}, search.Tweets)
+	_, _, err = client.Search.Recent("gophers", params)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"golang", "gophers"}, queries)
+	// the params may be reused for other queries
+	assert.Equal(t, &TweetSearchParams{MaxResults: 10}, params)
+}
+
+func TestSearchService_CountsRecent(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/2/tweets/counts/recent", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"query": "golang", "granularity": "day"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"data": [], "meta": {"total_tweet_count": 0}}`)
+	})
+
+	client := NewClient(httpClient)
+	params := &TweetCountsParams{Granularity: "day"}
+	_, _, err := client.Search.CountsRecent("golang", params)
+	assert.Nil(t, err)
+	assert.Equal(t, &TweetCountsParams{Granularity: "day"}, params)
+}
@@ -0,0 +1,164 @@
+package twitter
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// twitterEpoch is the time snowflake IDs count milliseconds from, in Unix
+// milliseconds.
+const twitterEpoch = 1288834974657
+
+// snowflakeTimeShift is the number of low bits of a snowflake ID below its
+// timestamp, which identify the worker and sequence.
+const snowflakeTimeShift = 22
+
+// TweetID identifies a Tweet. Twitter IDs are snowflakes, which embed the
+// time they were issued and so order objects by creation. Each kind of ID
+// has its own type, so IDs of different objects aren't mixed up, and each
+// has these methods:
+//
+//   - String returns the ID in decimal and Int64 returns it as an int64.
+//   - Time returns the time the ID was issued. IDs issued before snowflakes
+//     (November 2010, or 2013 for users) don't embed a time.
+//   - IDs decode from a JSON number or string and encode as a JSON string,
+//     since IDs exceed the integers JavaScript numbers hold exactly. They
+//     may also key JSON objects.
+//
+// The zero ID means no ID.
+// https://developer.twitter.com/en/docs/twitter-ids
+type TweetID int64
+
+// ParseTweetID parses a decimal TweetID.
+func ParseTweetID(s string) (TweetID, error) { return parseID[TweetID](s) }
+
+// TweetIDFromTime returns the lowest TweetID which could have been issued at
+// time t, for since_id and until_id (or max_id) bounds by time. Tweets
+// posted at or after t have IDs at least the returned ID.
+func TweetIDFromTime(t time.Time) TweetID {
+	ms := t.UnixMilli() - twitterEpoch
+	if ms < 0 {
+		return 0
+	}
+	return TweetID(ms << snowflakeTimeShift)
+}
+
+func (id TweetID) String() string                   { return formatID(id) }
+func (id TweetID) Int64() int64                     { return int64(id) }
+func (id TweetID) Time() time.Time                  { return idTime(id) }
+func (id TweetID) MarshalText() ([]byte, error)     { return []byte(formatID(id)), nil }
+func (id *TweetID) UnmarshalText(text []byte) error { return unmarshalIDText(text, id) }
+func (id *TweetID) UnmarshalJSON(data []byte) error { return unmarshalID(data, id) }
+
+// UserID identifies a User. It has the methods of TweetID.
+type UserID int64
+
+// ParseUserID parses a decimal UserID.
+func ParseUserID(s string) (UserID, error) { return parseID[UserID](s) }
+
+func (id UserID) String() string                   { return formatID(id) }
+func (id UserID) Int64() int64                     { return int64(id) }
+func (id UserID) Time() time.Time                  { return idTime(id) }
+func (id UserID) MarshalText() ([]byte, error)     { return []byte(formatID(id)), nil }
+func (id *UserID) UnmarshalText(text []byte) error { return unmarshalIDText(text, id) }
+func (id *UserID) UnmarshalJSON(data []byte) error { return unmarshalID(data, id) }
+
+// DirectMessageID identifies a Direct Message or Direct Message event. It
+// has the methods of TweetID.
+type DirectMessageID int64
+
+// ParseDirectMessageID parses a decimal DirectMessageID.
+func ParseDirectMessageID(s string) (DirectMessageID, error) { return parseID[DirectMessageID](s) }
+
+func (id DirectMessageID) String() string                   { return formatID(id) }
+func (id DirectMessageID) Int64() int64                     { return int64(id) }
+func (id DirectMessageID) Time() time.Time                  { return idTime(id) }
+func (id DirectMessageID) MarshalText() ([]byte, error)     { return []byte(formatID(id)), nil }
+func (id *DirectMessageID) UnmarshalText(text []byte) error { return unmarshalIDText(text, id) }
+func (id *DirectMessageID) UnmarshalJSON(data []byte) error { return unmarshalID(data, id) }
+
+// ListID identifies a List. It has the methods of TweetID.
+type ListID int64
+
+// ParseListID parses a decimal ListID.
+func ParseListID(s string) (ListID, error) { return parseID[ListID](s) }
+
+func (id ListID) String() string                   { return formatID(id) }
+func (id ListID) Int64() int64                     { return int64(id) }
+func (id ListID) Time() time.Time                  { return idTime(id) }
+func (id ListID) MarshalText() ([]byte, error)     { return []byte(formatID(id)), nil }
+func (id *ListID) UnmarshalText(text []byte) error { return unmarshalIDText(text, id) }
+func (id *ListID) UnmarshalJSON(data []byte) error { return unmarshalID(data, id) }
+
+// MediaID identifies uploaded media (see MediaService). It has the methods
+// of TweetID.
+type MediaID int64
+
+// ParseMediaID parses a decimal MediaID.
+func ParseMediaID(s string) (MediaID, error) { return parseID[MediaID](s) }
+
+func (id MediaID) String() string                   { return formatID(id) }
+func (id MediaID) Int64() int64                     { return int64(id) }
+func (id MediaID) Time() time.Time                  { return idTime(id) }
+func (id MediaID) MarshalText() ([]byte, error)     { return []byte(formatID(id)), nil }
+func (id *MediaID) UnmarshalText(text []byte) error { return unmarshalIDText(text, id) }
+func (id *MediaID) UnmarshalJSON(data []byte) error { return unmarshalID(data, id) }
+
+// snowflake is the underlying type of the ID types, which share the
+// functions below.
+type snowflake interface {
+	~int64
+}
+
+// parseID parses a decimal ID, treating the empty string as zero.
+func parseID[T snowflake](s string) (T, error) {
+	if s == "" {
+		return 0, nil
+	}
+	id, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("twitter: invalid id %q", s)
+	}
+	return T(id), nil
+}
+
+// formatID returns an ID in decimal.
+func formatID[T snowflake](id T) string {
+	return strconv.FormatInt(int64(id), 10)
+}
+
+// idTime returns the time embedded in a snowflake ID.
+func idTime[T snowflake](id T) time.Time {
+	return time.UnixMilli(int64(id)>>snowflakeTimeShift + twitterEpoch)
+}
+
+// unmarshalIDText decodes an ID from decimal.
+func unmarshalIDText[T snowflake](text []byte, id *T) error {
+	parsed, err := parseID[T](string(text))
+	*id = parsed
+	return err
+}
+
+// unmarshalID decodes an ID from a JSON number or string. null and the
+// empty string decode as zero.
+func unmarshalID[T snowflake](data []byte, id *T) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	s := string(data)
+	if len(data) >= 2 && data[0] == '"' && data[len(data)-1] == '"' {
+		unquoted, err := strconv.Unquote(s)
+		if err != nil {
+			return fmt.Errorf("twitter: invalid id %s", data)
+		}
+		s = unquoted
+	}
+	parsed, err := parseID[T](s)
+	if err != nil {
+		return err
+	}
+	*id = parsed
+	return nil
+}
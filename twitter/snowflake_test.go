@@ -0,0 +1,67 @@
+package twitter
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/go-querystring/query"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSnowflake_JSON(t *testing.T) {
+	var decoded struct {
+		Number  TweetID          `json:"number"`
+		String  UserID           `json:"string"`
+		Null    ListID           `json:"null"`
+		Empty   DirectMessageID  `json:"empty"`
+		Media   []MediaID        `json:"media"`
+		ByTweet map[TweetID]bool `json:"by_tweet"`
+	}
+	data := `{"number": 1445078208190291968, "string": "2244994945", "null": null, "empty": "",
+		"media": [1455952740635586573], "by_tweet": {"20": true}}`
+	assert.Nil(t, json.Unmarshal([]byte(data), &decoded))
+	assert.Equal(t, TweetID(1445078208190291968), decoded.Number)
+	assert.Equal(t, UserID(2244994945), decoded.String)
+	assert.Equal(t, ListID(0), decoded.Null)
+	assert.Equal(t, DirectMessageID(0), decoded.Empty)
+	assert.Equal(t, []MediaID{1455952740635586573}, decoded.Media)
+	assert.Equal(t, map[TweetID]bool{20: true}, decoded.ByTweet)
+
+	encoded, err := json.Marshal(decoded)
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"number": "1445078208190291968", "string": "2244994945", "null": "0", "empty": "0",
+		"media": ["1455952740635586573"], "by_tweet": {"20": true}}`, string(encoded))
+
+	var invalid TweetID
+	assert.Error(t, json.Unmarshal([]byte(`"12a"`), &invalid))
+	assert.Error(t, json.Unmarshal([]byte(`1.5`), &invalid))
+}
+
+func TestSnowflake_Query(t *testing.T) {
+	values, err := query.Values(&StatusUpdateParams{InReplyToStatusID: 20, MediaIds: []MediaID{1, 2}})
+	assert.Nil(t, err)
+	assert.Equal(t, "in_reply_to_status_id=20&media_ids=1%2C2", values.Encode())
+}
+
+func TestSnowflake_Time(t *testing.T) {
+	created := time.Date(2021, 10, 4, 17, 27, 47, 744000000, time.UTC)
+	assert.True(t, TweetID(1445078208190291968).Time().Equal(created))
+	// the lowest ID of a time is at most the IDs issued then
+	id := TweetIDFromTime(created)
+	assert.True(t, id.Time().Equal(created))
+	assert.True(t, id <= 1445078208190291968)
+	assert.Equal(t, TweetID(0), TweetIDFromTime(time.Date(2006, 3, 21, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestParseID(t *testing.T) {
+	id, err := ParseMediaID("1455952740635586573")
+	assert.Nil(t, err)
+	assert.Equal(t, MediaID(1455952740635586573), id)
+	assert.Equal(t, "1455952740635586573", id.String())
+	id, err = ParseMediaID("")
+	assert.Nil(t, err)
+	assert.Equal(t, MediaID(0), id)
+	_, err = ParseUserID("gopher")
+	assert.EqualError(t, err, `twitter: invalid id "gopher"`)
+}
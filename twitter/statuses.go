@@ -1,6 +1,8 @@
 package twitter
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
@@ -13,23 +15,26 @@ import (
 type Tweet struct {
 	Attachments struct {
 		MediaKeys []string `json:"media_keys,omitempty"`
-		PollID    []string `json:"poll_id,omitempty"`
+		PollID    []string `json:"poll_ids,omitempty"`
 	} `json:"attachments,omitempty"`
-	AuthorID           string               `json:"author_id"`
-	ContextAnnotations []*ContextAnnotation `json:"context_annotations"`
-	ConversationID     string               `json:"conversation_id"`
-	CreatedAt          string               `json:"created_at"`
-	Entities           Entities             `json:"entities"`
-	Geo                *Geo                 `json:"geo,omitempty"`
-	Includes           *Includes            `json:"includes"`
-	ID                 string               `json:"id"`
-	InReplyToStatusID  string               `json:"in_reply_to_status_id"`
-	InReplyToUserID    string               `json:"in_reply_to_user_id"`
-	Lang               string               `json:"lang"`
-	PossiblySensitive  bool                 `json:"possibly_sensitive"`
-	ReferencedTweets   []struct {
-		Type string `json:"type"`
-		ID   string `json:"id"`
+	AuthorID            UserID               `json:"author_id"`
+	ContextAnnotations  []*ContextAnnotation `json:"context_annotations"`
+	ConversationID      TweetID              `json:"conversation_id"`
+	CreatedAt           Timestamp            `json:"created_at"`
+	EditControls        *EditControls        `json:"edit_controls,omitempty"`
+	EditHistoryTweetIDs []TweetID            `json:"edit_history_tweet_ids,omitempty"`
+	Entities            Entities             `json:"entities"`
+	Geo                 *Geo                 `json:"geo,omitempty"`
+	Includes            *Includes            `json:"includes"`
+	ID                  TweetID              `json:"id"`
+	InReplyToStatusID   TweetID              `json:"in_reply_to_status_id"`
+	InReplyToUserID     UserID               `json:"in_reply_to_user_id"`
+	Lang                string               `json:"lang"`
+	NoteTweet           *NoteTweet           `json:"note_tweet,omitempty"`
+	PossiblySensitive   bool                 `json:"possibly_sensitive"`
+	ReferencedTweets    []struct {
+		Type string  `json:"type"`
+		ID   TweetID `json:"id"`
 	} `json:"referenced_tweets,omitempty"`
 	PublicMetrics    *Metrics  `json:"public_metrics,omitempty"`
 	NonPublicMetrics *Metrics  `json:"non_public_metrics,omitempty"`
@@ -39,11 +44,33 @@ type Tweet struct {
 	Source           string    `json:"source"`
 	Text             string    `json:"text"`
 	Withheld         *Withheld `json:"withheld"`
+	// includes resolves the Tweet's references, see Hydrate
+	includes *Includes
 }
 
-// CreatedAtTime returns the time a tweet was created.
+// CreatedAtTime returns the time a tweet was created, or an error if the
+// created_at field was not requested.
+//
+// Deprecated: use CreatedAt, which is decoded as a Timestamp.
 func (t Tweet) CreatedAtTime() (time.Time, error) {
-	return time.Parse(time.RubyDate, t.CreatedAt)
+	if t.CreatedAt.IsZero() {
+		return time.Time{}, errors.New("twitter: tweet has no created_at")
+	}
+	return t.CreatedAt.Time, nil
+}
+
+// EditControls describes whether and until when a Tweet may be edited.
+type EditControls struct {
+	EditsRemaining int       `json:"edits_remaining"`
+	IsEditEligible bool      `json:"is_edit_eligible"`
+	EditableUntil  Timestamp `json:"editable_until"`
+}
+
+// NoteTweet is the full text and entities of a Tweet longer than 280
+// characters, whose Text is truncated.
+type NoteTweet struct {
+	Text     string   `json:"text"`
+	Entities Entities `json:"entities"`
 }
 
 type Geo struct {
@@ -57,7 +84,7 @@ type Withheld struct {
 	Scope       string   `json:"scope"`
 }
 
-//Metrics list metrics associated with the tweet (counts of retweets, replies, likes, quotes).
+// Metrics list metrics associated with the tweet (counts of retweets, replies, likes, quotes).
 type Metrics struct {
 	RetweetCount int64 `json:"retweet_count"`
 	ReplyCount   int64 `json:"reply_count"`
@@ -81,7 +108,7 @@ type Poll struct {
 	ID              string       `json:"id"`
 	Options         []PollOption `json:"options"`
 	DurationMinutes int64        `json:"duration_minutes"`
-	End             string       `json:"end_datetime"`
+	End             Timestamp    `json:"end_datetime"`
 	VotingStatus    string       `json:"voting_status"`
 }
 
@@ -122,89 +149,118 @@ func newStatusService(sling *sling.Sling) *StatusService {
 
 // StatusShowParams are the parameters for StatusService.Show
 type StatusShowParams struct {
-	ID               int64  `url:"id,omitempty"`
-	TrimUser         *bool  `url:"trim_user,omitempty"`
-	IncludeMyRetweet *bool  `url:"include_my_retweet,omitempty"`
-	IncludeEntities  *bool  `url:"include_entities,omitempty"`
-	TweetMode        string `url:"tweet_mode,omitempty"`
+	ID               TweetID `url:"id,omitempty"`
+	TrimUser         *bool   `url:"trim_user,omitempty"`
+	IncludeMyRetweet *bool   `url:"include_my_retweet,omitempty"`
+	IncludeEntities  *bool   `url:"include_entities,omitempty"`
+	TweetMode        string  `url:"tweet_mode,omitempty"`
 }
 
 // Show returns the requested Tweet.
 // https://dev.twitter.com/rest/reference/get/statuses/show/%3Aid
-func (s *StatusService) Show(id int64, params *StatusShowParams) (*Tweet, *http.Response, error) {
+//
+// Deprecated: the v1.1 endpoint is not available from the v2 API base URL,
+// use TweetService.LookupByID.
+func (s *StatusService) Show(id TweetID, params *StatusShowParams) (*Tweet, *http.Response, error) {
+	return s.ShowWithContext(context.Background(), id, params)
+}
+
+// ShowWithContext is like Show but uses the given context for the request.
+func (s *StatusService) ShowWithContext(ctx context.Context, id TweetID, params *StatusShowParams) (*Tweet, *http.Response, error) {
 	if params == nil {
 		params = &StatusShowParams{}
 	}
 	params.ID = id
 	tweet := new(Tweet)
 	apiError := new(APIError)
-	resp, err := s.sling.New().Get("show.json").QueryStruct(params).Receive(tweet, apiError)
-	return tweet, resp, relevantError(err, *apiError)
+	resp, err := receive(ctx, s.sling.New().Get("show.json").QueryStruct(params), tweet, apiError)
+	return tweet, resp, relevantError(resp, err, *apiError)
 }
 
 // StatusLookupParams are the parameters for StatusService.Lookup
 type StatusLookupParams struct {
-	ID              []int64 `url:"id,omitempty,comma"`
-	TrimUser        *bool   `url:"trim_user,omitempty"`
-	IncludeEntities *bool   `url:"include_entities,omitempty"`
-	Map             *bool   `url:"map,omitempty"`
-	TweetMode       string  `url:"tweet_mode,omitempty"`
+	ID              []TweetID `url:"id,omitempty,comma"`
+	TrimUser        *bool     `url:"trim_user,omitempty"`
+	IncludeEntities *bool     `url:"include_entities,omitempty"`
+	Map             *bool     `url:"map,omitempty"`
+	TweetMode       string    `url:"tweet_mode,omitempty"`
 }
 
 // Lookup returns the requested Tweets as a slice. Combines ids from the
 // required ids argument and from params.Id.
 // https://dev.twitter.com/rest/reference/get/statuses/lookup
-func (s *StatusService) Lookup(ids []int64, params *StatusLookupParams) ([]Tweet, *http.Response, error) {
+//
+// Deprecated: the v1.1 endpoint is not available from the v2 API base URL,
+// use TweetService.Lookup.
+func (s *StatusService) Lookup(ids []TweetID, params *StatusLookupParams) ([]Tweet, *http.Response, error) {
+	return s.LookupWithContext(context.Background(), ids, params)
+}
+
+// LookupWithContext is like Lookup but uses the given context for the request.
+func (s *StatusService) LookupWithContext(ctx context.Context, ids []TweetID, params *StatusLookupParams) ([]Tweet, *http.Response, error) {
 	if params == nil {
 		params = &StatusLookupParams{}
 	}
 	params.ID = append(params.ID, ids...)
 	tweets := new([]Tweet)
 	apiError := new(APIError)
-	resp, err := s.sling.New().Get("lookup.json").QueryStruct(params).Receive(tweets, apiError)
-	return *tweets, resp, relevantError(err, *apiError)
+	resp, err := receive(ctx, s.sling.New().Get("lookup.json").QueryStruct(params), tweets, apiError)
+	return *tweets, resp, relevantError(resp, err, *apiError)
 }
 
 // StatusUpdateParams are the parameters for StatusService.Update
 type StatusUpdateParams struct {
-	Status             string   `url:"status,omitempty"`
-	InReplyToStatusID  int64    `url:"in_reply_to_status_id,omitempty"`
-	PossiblySensitive  *bool    `url:"possibly_sensitive,omitempty"`
-	Lat                *float64 `url:"lat,omitempty"`
-	Long               *float64 `url:"long,omitempty"`
-	PlaceID            string   `url:"place_id,omitempty"`
-	DisplayCoordinates *bool    `url:"display_coordinates,omitempty"`
-	TrimUser           *bool    `url:"trim_user,omitempty"`
-	MediaIds           []int64  `url:"media_ids,omitempty,comma"`
-	TweetMode          string   `url:"tweet_mode,omitempty"`
+	Status             string    `url:"status,omitempty"`
+	InReplyToStatusID  TweetID   `url:"in_reply_to_status_id,omitempty"`
+	PossiblySensitive  *bool     `url:"possibly_sensitive,omitempty"`
+	Lat                *float64  `url:"lat,omitempty"`
+	Long               *float64  `url:"long,omitempty"`
+	PlaceID            string    `url:"place_id,omitempty"`
+	DisplayCoordinates *bool     `url:"display_coordinates,omitempty"`
+	TrimUser           *bool     `url:"trim_user,omitempty"`
+	MediaIds           []MediaID `url:"media_ids,omitempty,comma"`
+	TweetMode          string    `url:"tweet_mode,omitempty"`
 }
 
 // Update updates the user's status, also known as Tweeting.
 // Requires a user auth context.
 // https://dev.twitter.com/rest/reference/post/statuses/update
+//
+// Deprecated: the v1.1 endpoint is not available from the v2 API base URL,
+// use TweetService.Create.
 func (s *StatusService) Update(status string, params *StatusUpdateParams) (*Tweet, *http.Response, error) {
+	return s.UpdateWithContext(context.Background(), status, params)
+}
+
+// UpdateWithContext is like Update but uses the given context for the request.
+func (s *StatusService) UpdateWithContext(ctx context.Context, status string, params *StatusUpdateParams) (*Tweet, *http.Response, error) {
 	if params == nil {
 		params = &StatusUpdateParams{}
 	}
 	params.Status = status
 	tweet := new(Tweet)
 	apiError := new(APIError)
-	resp, err := s.sling.New().Post("update.json").BodyForm(params).Receive(tweet, apiError)
-	return tweet, resp, relevantError(err, *apiError)
+	resp, err := receive(ctx, s.sling.New().Post("update.json").BodyForm(params), tweet, apiError)
+	return tweet, resp, relevantError(resp, err, *apiError)
 }
 
 // StatusRetweetParams are the parameters for StatusService.Retweet
 type StatusRetweetParams struct {
-	ID        int64  `url:"id,omitempty"`
-	TrimUser  *bool  `url:"trim_user,omitempty"`
-	TweetMode string `url:"tweet_mode,omitempty"`
+	ID        TweetID `url:"id,omitempty"`
+	TrimUser  *bool   `url:"trim_user,omitempty"`
+	TweetMode string  `url:"tweet_mode,omitempty"`
 }
 
 // Retweet retweets the Tweet with the given id and returns the original Tweet
 // with embedded retweet details.
 // Requires a user auth context.
 // https://dev.twitter.com/rest/reference/post/statuses/retweet/%3Aid
-func (s *StatusService) Retweet(id int64, params *StatusRetweetParams) (*Tweet, *http.Response, error) {
+func (s *StatusService) Retweet(id TweetID, params *StatusRetweetParams) (*Tweet, *http.Response, error) {
+	return s.RetweetWithContext(context.Background(), id, params)
+}
+
+// RetweetWithContext is like Retweet but uses the given context for the request.
+func (s *StatusService) RetweetWithContext(ctx context.Context, id TweetID, params *StatusRetweetParams) (*Tweet, *http.Response, error) {
 	if params == nil {
 		params = &StatusRetweetParams{}
 	}
@@ -212,21 +268,26 @@ func (s *StatusService) Retweet(id int64, params *StatusRetweetParams) (*Tweet,
 	tweet := new(Tweet)
 	apiError := new(APIError)
 	path := fmt.Sprintf("retweet/%d.json", params.ID)
-	resp, err := s.sling.New().Post(path).BodyForm(params).Receive(tweet, apiError)
-	return tweet, resp, relevantError(err, *apiError)
+	resp, err := receive(ctx, s.sling.New().Post(path).BodyForm(params), tweet, apiError)
+	return tweet, resp, relevantError(resp, err, *apiError)
 }
 
 // StatusUnretweetParams are the parameters for StatusService.Unretweet
 type StatusUnretweetParams struct {
-	ID        int64  `url:"id,omitempty"`
-	TrimUser  *bool  `url:"trim_user,omitempty"`
-	TweetMode string `url:"tweet_mode,omitempty"`
+	ID        TweetID `url:"id,omitempty"`
+	TrimUser  *bool   `url:"trim_user,omitempty"`
+	TweetMode string  `url:"tweet_mode,omitempty"`
 }
 
 // Unretweet unretweets the Tweet with the given id and returns the original Tweet.
 // Requires a user auth context.
 // https://dev.twitter.com/rest/reference/post/statuses/unretweet/%3Aid
-func (s *StatusService) Unretweet(id int64, params *StatusUnretweetParams) (*Tweet, *http.Response, error) {
+func (s *StatusService) Unretweet(id TweetID, params *StatusUnretweetParams) (*Tweet, *http.Response, error) {
+	return s.UnretweetWithContext(context.Background(), id, params)
+}
+
+// UnretweetWithContext is like Unretweet but uses the given context for the request.
+func (s *StatusService) UnretweetWithContext(ctx context.Context, id TweetID, params *StatusUnretweetParams) (*Tweet, *http.Response, error) {
 	if params == nil {
 		params = &StatusUnretweetParams{}
 	}
@@ -234,21 +295,26 @@ func (s *StatusService) Unretweet(id int64, params *StatusUnretweetParams) (*Twe
 	tweet := new(Tweet)
 	apiError := new(APIError)
 	path := fmt.Sprintf("unretweet/%d.json", params.ID)
-	resp, err := s.sling.New().Post(path).BodyForm(params).Receive(tweet, apiError)
-	return tweet, resp, relevantError(err, *apiError)
+	resp, err := receive(ctx, s.sling.New().Post(path).BodyForm(params), tweet, apiError)
+	return tweet, resp, relevantError(resp, err, *apiError)
 }
 
 // StatusRetweetsParams are the parameters for StatusService.Retweets
 type StatusRetweetsParams struct {
-	ID        int64  `url:"id,omitempty"`
-	Count     int    `url:"count,omitempty"`
-	TrimUser  *bool  `url:"trim_user,omitempty"`
-	TweetMode string `url:"tweet_mode,omitempty"`
+	ID        TweetID `url:"id,omitempty"`
+	Count     int     `url:"count,omitempty"`
+	TrimUser  *bool   `url:"trim_user,omitempty"`
+	TweetMode string  `url:"tweet_mode,omitempty"`
 }
 
 // Retweets returns the most recent retweets of the Tweet with the given id.
 // https://dev.twitter.com/rest/reference/get/statuses/retweets/%3Aid
-func (s *StatusService) Retweets(id int64, params *StatusRetweetsParams) ([]Tweet, *http.Response, error) {
+func (s *StatusService) Retweets(id TweetID, params *StatusRetweetsParams) ([]Tweet, *http.Response, error) {
+	return s.RetweetsWithContext(context.Background(), id, params)
+}
+
+// RetweetsWithContext is like Retweets but uses the given context for the request.
+func (s *StatusService) RetweetsWithContext(ctx context.Context, id TweetID, params *StatusRetweetsParams) ([]Tweet, *http.Response, error) {
 	if params == nil {
 		params = &StatusRetweetsParams{}
 	}
@@ -256,21 +322,26 @@ func (s *StatusService) Retweets(id int64, params *StatusRetweetsParams) ([]Twee
 	tweets := new([]Tweet)
 	apiError := new(APIError)
 	path := fmt.Sprintf("retweets/%d.json", params.ID)
-	resp, err := s.sling.New().Get(path).QueryStruct(params).Receive(tweets, apiError)
-	return *tweets, resp, relevantError(err, *apiError)
+	resp, err := receive(ctx, s.sling.New().Get(path).QueryStruct(params), tweets, apiError)
+	return *tweets, resp, relevantError(resp, err, *apiError)
 }
 
 // StatusDestroyParams are the parameters for StatusService.Destroy
 type StatusDestroyParams struct {
-	ID        int64  `url:"id,omitempty"`
-	TrimUser  *bool  `url:"trim_user,omitempty"`
-	TweetMode string `url:"tweet_mode,omitempty"`
+	ID        TweetID `url:"id,omitempty"`
+	TrimUser  *bool   `url:"trim_user,omitempty"`
+	TweetMode string  `url:"tweet_mode,omitempty"`
 }
 
 // Destroy deletes the Tweet with the given id and returns it if successful.
 // Requires a user auth context.
 // https://dev.twitter.com/rest/reference/post/statuses/destroy/%3Aid
-func (s *StatusService) Destroy(id int64, params *StatusDestroyParams) (*Tweet, *http.Response, error) {
+func (s *StatusService) Destroy(id TweetID, params *StatusDestroyParams) (*Tweet, *http.Response, error) {
+	return s.DestroyWithContext(context.Background(), id, params)
+}
+
+// DestroyWithContext is like Destroy but uses the given context for the request.
+func (s *StatusService) DestroyWithContext(ctx context.Context, id TweetID, params *StatusDestroyParams) (*Tweet, *http.Response, error) {
 	if params == nil {
 		params = &StatusDestroyParams{}
 	}
@@ -278,8 +349,8 @@ func (s *StatusService) Destroy(id int64, params *StatusDestroyParams) (*Tweet,
 	tweet := new(Tweet)
 	apiError := new(APIError)
 	path := fmt.Sprintf("destroy/%d.json", params.ID)
-	resp, err := s.sling.New().Post(path).BodyForm(params).Receive(tweet, apiError)
-	return tweet, resp, relevantError(err, *apiError)
+	resp, err := receive(ctx, s.sling.New().Post(path).BodyForm(params), tweet, apiError)
+	return tweet, resp, relevantError(resp, err, *apiError)
 }
 
 // OEmbedTweet represents a Tweet in oEmbed format.
@@ -299,22 +370,27 @@ type OEmbedTweet struct {
 
 // StatusOEmbedParams are the parameters for StatusService.OEmbed
 type StatusOEmbedParams struct {
-	ID         int64  `url:"id,omitempty"`
-	URL        string `url:"url,omitempty"`
-	Align      string `url:"align,omitempty"`
-	MaxWidth   int64  `url:"maxwidth,omitempty"`
-	HideMedia  *bool  `url:"hide_media,omitempty"`
-	HideThread *bool  `url:"hide_media,omitempty"`
-	OmitScript *bool  `url:"hide_media,omitempty"`
-	WidgetType string `url:"widget_type,omitempty"`
-	HideTweet  *bool  `url:"hide_tweet,omitempty"`
+	ID         TweetID `url:"id,omitempty"`
+	URL        string  `url:"url,omitempty"`
+	Align      string  `url:"align,omitempty"`
+	MaxWidth   int64   `url:"maxwidth,omitempty"`
+	HideMedia  *bool   `url:"hide_media,omitempty"`
+	HideThread *bool   `url:"hide_media,omitempty"`
+	OmitScript *bool   `url:"hide_media,omitempty"`
+	WidgetType string  `url:"widget_type,omitempty"`
+	HideTweet  *bool   `url:"hide_tweet,omitempty"`
 }
 
 // OEmbed returns the requested Tweet in oEmbed format.
 // https://dev.twitter.com/rest/reference/get/statuses/oembed
 func (s *StatusService) OEmbed(params *StatusOEmbedParams) (*OEmbedTweet, *http.Response, error) {
+	return s.OEmbedWithContext(context.Background(), params)
+}
+
+// OEmbedWithContext is like OEmbed but uses the given context for the request.
+func (s *StatusService) OEmbedWithContext(ctx context.Context, params *StatusOEmbedParams) (*OEmbedTweet, *http.Response, error) {
 	oEmbedTweet := new(OEmbedTweet)
 	apiError := new(APIError)
-	resp, err := s.sling.New().Get("oembed.json").QueryStruct(params).Receive(oEmbedTweet, apiError)
-	return oEmbedTweet, resp, relevantError(err, *apiError)
+	resp, err := receive(ctx, s.sling.New().Get("oembed.json").QueryStruct(params), oEmbedTweet, apiError)
+	return oEmbedTweet, resp, relevantError(resp, err, *apiError)
 }
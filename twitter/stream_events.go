@@ -0,0 +1,60 @@
+package twitter
+
+import (
+	"time"
+)
+
+// StreamEventType is the kind of a StreamEvent.
+type StreamEventType int
+
+const (
+	// StreamConnecting is reported before each connection attempt.
+	StreamConnecting StreamEventType = iota
+	// StreamConnected is reported when Twitter accepts a connection.
+	StreamConnected
+	// StreamDisconnected is reported when a connection ends, with the Err
+	// which ended it, before the stream reconnects.
+	StreamDisconnected
+	// StreamStalled is reported when a connection is closed because
+	// nothing was received for the stall timeout, before it is reported
+	// disconnected with ErrStreamStalled.
+	StreamStalled
+	// StreamBackingOff is reported before waiting to reconnect, with the
+	// Wait and the StatusCode of the rejected connection, if any.
+	StreamBackingOff
+	// StreamFailed is reported when the stream stops reconnecting, with the
+	// Err also returned by Stream.Err.
+	StreamFailed
+)
+
+func (t StreamEventType) String() string {
+	switch t {
+	case StreamConnecting:
+		return "connecting"
+	case StreamConnected:
+		return "connected"
+	case StreamDisconnected:
+		return "disconnected"
+	case StreamStalled:
+		return "stalled"
+	case StreamBackingOff:
+		return "backing off"
+	case StreamFailed:
+		return "failed"
+	}
+	return "unknown"
+}
+
+// StreamEvent describes a change in the connection of a Stream, for
+// logging or monitoring why a stream reconnects or stops.
+type StreamEvent struct {
+	Type StreamEventType
+	// Err is the reason a connection ended or the stream failed, if known.
+	Err error
+	// StatusCode and Body are the HTTP status and body of a response which
+	// rejected a connection.
+	StatusCode int
+	Body       []byte
+	// Wait is the time until the next connection attempt.
+	Wait time.Duration
+}
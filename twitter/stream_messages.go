@@ -1,12 +1,76 @@
 package twitter
 
+import (
+	"fmt"
+)
+
+// StreamMessage is a message received on a v2 stream: a *StreamData, a
+// *StreamError, a *StreamKeepAlive, or a *StreamDecodeError. Use a type
+// switch to handle each, or MessagesOf to receive only one type.
+type StreamMessage interface {
+	streamMessage()
+}
+
+func (*StreamData) streamMessage()        {}
+func (*StreamError) streamMessage()       {}
+func (*StreamKeepAlive) streamMessage()   {}
+func (*StreamDecodeError) streamMessage() {}
+
+// StreamError is a v2 stream message carrying errors instead of a Tweet,
+// such as the operational disconnect Twitter sends before closing a stream.
+// https://developer.twitter.com/en/docs/twitter-api/tweets/filtered-stream/integrate/handling-disconnections
+type StreamError struct {
+	Errors []ErrorDetail `json:"errors"`
+}
+
+func (e *StreamError) Error() string {
+	return APIError{Errors: e.Errors}.Error()
+}
+
+// IsDisconnect returns true if the errors report that Twitter is closing the
+// stream (e.g. an operational disconnect), after which the stream reconnects.
+func (e *StreamError) IsDisconnect() bool {
+	for _, err := range e.Errors {
+		if err.DisconnectType != "" || err.Title == "operational-disconnect" {
+			return true
+		}
+	}
+	return false
+}
+
+// StreamKeepAlive is the blank line Twitter sends on an idle stream every
+// 20 seconds to keep the connection open.
+type StreamKeepAlive struct{}
+
+// StreamDecodeError is a stream message which could not be decoded. Raw is
+// the message as received.
+type StreamDecodeError struct {
+	Raw []byte
+	Err error
+}
+
+func (e *StreamDecodeError) Error() string {
+	return fmt.Sprintf("twitter: undecodable stream message: %v", e.Err)
+}
+
+// Unwrap returns the decoding error.
+func (e *StreamDecodeError) Unwrap() error {
+	return e.Err
+}
+
+// newStreamDecodeError returns a StreamDecodeError with a copy of the token,
+// which the stream reader reuses.
+func newStreamDecodeError(token []byte, err error) *StreamDecodeError {
+	return &StreamDecodeError{Raw: append([]byte(nil), token...), Err: err}
+}
+
 // StatusDeletion indicates that a given Tweet has been deleted.
 // https://dev.twitter.com/streaming/overview/messages-types#status_deletion_notices_delete
 type StatusDeletion struct {
-	ID        int64  `json:"id"`
-	IDStr     string `json:"id_str"`
-	UserID    int64  `json:"user_id"`
-	UserIDStr string `json:"user_id_str"`
+	ID        TweetID `json:"id"`
+	IDStr     string  `json:"id_str"`
+	UserID    UserID  `json:"user_id"`
+	UserIDStr string  `json:"user_id_str"`
 }
 
 type statusDeletionNotice struct {
@@ -19,10 +83,10 @@ type statusDeletionNotice struct {
 // of Tweets.
 // https://dev.twitter.com/streaming/overview/messages-types#Location_deletion_notices_scrub_geo
 type LocationDeletion struct {
-	UserID          int64  `json:"user_id"`
-	UserIDStr       string `json:"user_id_str"`
-	UpToStatusID    int64  `json:"up_to_status_id"`
-	UpToStatusIDStr string `json:"up_to_status_id_str"`
+	UserID          UserID  `json:"user_id"`
+	UserIDStr       string  `json:"user_id_str"`
+	UpToStatusID    TweetID `json:"up_to_status_id"`
+	UpToStatusIDStr string  `json:"up_to_status_id_str"`
 }
 
 type locationDeletionNotice struct {
@@ -44,8 +108,8 @@ type streamLimitNotice struct {
 // has been withheld in certain countries.
 // https://dev.twitter.com/streaming/overview/messages-types#withheld_content_notices
 type StatusWithheld struct {
-	ID                  int64    `json:"id"`
-	UserID              int64    `json:"user_id"`
+	ID                  TweetID  `json:"id"`
+	UserID              UserID   `json:"user_id"`
 	WithheldInCountries []string `json:"withheld_in_countries"`
 }
 
@@ -57,7 +121,7 @@ type statusWithheldNotice struct {
 // certain countries.
 // https://dev.twitter.com/streaming/overview/messages-types#withheld_content_notices
 type UserWithheld struct {
-	ID                  int64    `json:"id"`
+	ID                  UserID   `json:"id"`
 	WithheldInCountries []string `json:"withheld_in_countries"`
 }
 type userWithheldNotice struct {
@@ -91,7 +155,7 @@ type stallWarningNotice struct {
 // FriendsList is a list of some of a user's friends.
 // https://dev.twitter.com/streaming/overview/messages-types#friends_list_friends
 type FriendsList struct {
-	Friends []int64 `json:"friends"`
+	Friends []UserID `json:"friends"`
 }
 
 type directMessageNotice struct {
@@ -101,10 +165,10 @@ type directMessageNotice struct {
 // Event is a non-Tweet notification message (e.g. like, retweet, follow).
 // https://dev.twitter.com/streaming/overview/messages-types#Events_event
 type Event struct {
-	Event     string `json:"event"`
-	CreatedAt string `json:"created_at"`
-	Target    *User  `json:"target"`
-	Source    *User  `json:"source"`
+	Event     string    `json:"event"`
+	CreatedAt Timestamp `json:"created_at"`
+	Target    *User     `json:"target"`
+	Source    *User     `json:"source"`
 	// TODO: add List or deprecate it
 	TargetObject *Tweet `json:"target_object"`
 }
@@ -0,0 +1,130 @@
+package twitter
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+)
+
+// streamRecord is a line of a stream recording: a raw stream message, or an
+// empty keep-alive, and the time it was received.
+type streamRecord struct {
+	ReceivedAt time.Time `json:"received_at"`
+	Line       string    `json:"line"`
+}
+
+// StreamRecorder records the raw messages and keep-alives received on
+// streams as NDJSON, one JSON object per line with the time each was
+// received, to be replayed by ReplayStream. Set it as the StreamParams
+// Recorder. A StreamRecorder may be shared by streams.
+type StreamRecorder struct {
+	mu      sync.Mutex
+	encoder *json.Encoder
+	err     error
+}
+
+// NewStreamRecorder returns a StreamRecorder which writes to w, such as an
+// *os.File.
+func NewStreamRecorder(w io.Writer) *StreamRecorder {
+	return &StreamRecorder{encoder: json.NewEncoder(w)}
+}
+
+// Err returns the first error writing the recording, after which nothing
+// more is recorded.
+func (r *StreamRecorder) Err() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.err
+}
+
+// record writes the line received at the given time.
+func (r *StreamRecorder) record(line []byte, receivedAt time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.err != nil {
+		return
+	}
+	r.err = r.encoder.Encode(streamRecord{ReceivedAt: receivedAt, Line: string(line)})
+}
+
+// StreamReplayParams are the parameters for ReplayStream.
+type StreamReplayParams struct {
+	// Speed is the pace of the replay relative to the recording, e.g. 10
+	// replays ten times faster. Zero replays at the original pace and a
+	// negative Speed replays without waiting.
+	Speed float64
+}
+
+// ReplayStream returns a Stream which receives the messages of a recording
+// made by a StreamRecorder, waiting between them as they were received, for
+// exercising stream handlers (e.g. a SwitchDemux) offline. Messages is closed
+// at the end of the recording, and Err returns any error reading it.
+func ReplayStream(ctx context.Context, recording io.Reader, params *StreamReplayParams) *Stream {
+	if params == nil {
+		params = &StreamReplayParams{}
+	}
+	speed := params.Speed
+	if speed == 0 {
+		speed = 1
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	s := &Stream{
+		Messages: make(chan interface{}),
+		ctx:      ctx,
+		cancel:   cancel,
+		group:    &sync.WaitGroup{},
+	}
+	s.group.Add(1)
+	go s.replay(recording, speed)
+	return s
+}
+
+// replay receives the messages of the recording as if they were read from a
+// stream response body.
+func (s *Stream) replay(recording io.Reader, speed float64) {
+	// close Messages channel and decrement the wait group counter
+	defer close(s.Messages)
+	defer s.group.Done()
+	defer s.cancel()
+
+	body, w := io.Pipe()
+	written := make(chan struct{})
+	go func() {
+		defer close(written)
+		w.CloseWithError(s.writeReplay(w, recording, speed))
+	}()
+	err := s.receive(body)
+	// unblock the writer if receiving stopped first
+	body.Close()
+	<-written
+	if err != io.EOF && !stopped(s.ctx.Done()) {
+		s.mu.Lock()
+		s.err = err
+		s.mu.Unlock()
+	}
+}
+
+// writeReplay writes the lines of the recording to w, delimited as in a
+// stream response body, waiting between them according to the speed.
+func (s *Stream) writeReplay(w io.Writer, recording io.Reader, speed float64) error {
+	decoder := json.NewDecoder(recording)
+	var last time.Time
+	for {
+		var record streamRecord
+		if err := decoder.Decode(&record); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		if speed > 0 && !last.IsZero() {
+			sleepOrDone(time.Duration(float64(record.ReceivedAt.Sub(last))/speed), s.ctx.Done())
+		}
+		last = record.ReceivedAt
+		if _, err := io.WriteString(w, record.Line+"\r\n"); err != nil {
+			return err
+		}
+	}
+}
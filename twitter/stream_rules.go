@@ -0,0 +1,240 @@
+package twitter
+
+import (
+	"context"
+	"net/http"
+)
+
+// RuleID identifies a filtered stream rule. Tweets delivered on a filtered
+// stream list the IDs of the rules they matched in StreamData.MatchingRules.
+type RuleID string
+
+// StreamRule is a filtered stream rule. The Value is the rule's query and
+// the optional Tag is a label echoed back with matching Tweets.
+// https://developer.twitter.com/en/docs/twitter-api/tweets/filtered-stream/integrate/build-a-rule
+type StreamRule struct {
+	ID    RuleID `json:"id,omitempty"`
+	Value string `json:"value"`
+	Tag   string `json:"tag,omitempty"`
+}
+
+// StreamRules lists filtered stream rules returned by the rules endpoints,
+// along with errors for any rules which could not be added or deleted.
+type StreamRules struct {
+	Rules  []StreamRule     `json:"data"`
+	Meta   *StreamRulesMeta `json:"meta"`
+	Errors []ErrorDetail    `json:"errors"`
+}
+
+// StreamRulesMeta describes a StreamRules response.
+type StreamRulesMeta struct {
+	Sent        string              `json:"sent"`
+	ResultCount int                 `json:"result_count"`
+	Summary     *StreamRulesSummary `json:"summary"`
+}
+
+// StreamRulesSummary counts the rules affected by an add or delete request.
+type StreamRulesSummary struct {
+	Created    int `json:"created"`
+	NotCreated int `json:"not_created"`
+	Valid      int `json:"valid"`
+	Invalid    int `json:"invalid"`
+	Deleted    int `json:"deleted"`
+	NotDeleted int `json:"not_deleted"`
+}
+
+// StreamRulesSync summarizes the changes StreamService.RulesSync made to
+// the live rules.
+type StreamRulesSync struct {
+	// Added lists the rules which were added, with their new IDs.
+	Added []StreamRule
+	// Deleted lists the live rules which were deleted.
+	Deleted []StreamRule
+	// Unchanged lists the live rules which were already as desired.
+	Unchanged []StreamRule
+}
+
+// StreamRulesGetParams are the parameters for StreamService.RulesGet.
+type StreamRulesGetParams struct {
+	IDs []RuleID `url:"ids,omitempty,comma"`
+}
+
+// RulesGet returns the filtered stream rules, or only those with the
+// given IDs.
+// https://developer.twitter.com/en/docs/twitter-api/tweets/filtered-stream/api-reference/get-tweets-search-stream-rules
+func (srv *StreamService) RulesGet(params *StreamRulesGetParams) (*StreamRules, *http.Response, error) {
+	return srv.RulesGetWithContext(context.Background(), params)
+}
+
+// RulesGetWithContext is like RulesGet but uses the given context for the request.
+func (srv *StreamService) RulesGetWithContext(ctx context.Context, params *StreamRulesGetParams) (*StreamRules, *http.Response, error) {
+	rules := new(StreamRules)
+	apiError := new(APIError)
+	resp, err := receive(ctx, srv.filteredStream.New().Get("stream/rules").QueryStruct(params), rules, apiError)
+	return rules, resp, relevantError(resp, err, *apiError)
+}
+
+// StreamRulesAddParams are the parameters for StreamService.RulesAdd.
+type StreamRulesAddParams struct {
+	DryRun bool `url:"dry_run,omitempty"`
+}
+
+// RulesAdd adds filtered stream rules and returns them with their IDs. If
+// any rule could not be added, an APIError listing the rejected rules is
+// returned along with the rules which were added.
+// https://developer.twitter.com/en/docs/twitter-api/tweets/filtered-stream/api-reference/post-tweets-search-stream-rules
+func (srv *StreamService) RulesAdd(rules []StreamRule, params *StreamRulesAddParams) (*StreamRules, *http.Response, error) {
+	return srv.RulesAddWithContext(context.Background(), rules, params)
+}
+
+// RulesAddWithContext is like RulesAdd but uses the given context for the request.
+func (srv *StreamService) RulesAddWithContext(ctx context.Context, rules []StreamRule, params *StreamRulesAddParams) (*StreamRules, *http.Response, error) {
+	body := &struct {
+		Add []StreamRule `json:"add"`
+	}{}
+	for _, rule := range rules {
+		// rule IDs are assigned by Twitter
+		body.Add = append(body.Add, StreamRule{Value: rule.Value, Tag: rule.Tag})
+	}
+	return srv.postRules(ctx, body, params)
+}
+
+// RulesDryRun validates filtered stream rules without adding them. If any
+// rule is invalid, an APIError describing the problems is returned.
+func (srv *StreamService) RulesDryRun(rules []StreamRule) (*StreamRules, *http.Response, error) {
+	return srv.RulesDryRunWithContext(context.Background(), rules)
+}
+
+// RulesDryRunWithContext is like RulesDryRun but uses the given context for the request.
+func (srv *StreamService) RulesDryRunWithContext(ctx context.Context, rules []StreamRule) (*StreamRules, *http.Response, error) {
+	return srv.RulesAddWithContext(ctx, rules, &StreamRulesAddParams{DryRun: true})
+}
+
+// StreamRulesDeleteParams are the parameters for StreamService.RulesDelete
+// and StreamService.RulesDeleteByValue.
+type StreamRulesDeleteParams struct {
+	DryRun bool `url:"dry_run,omitempty"`
+}
+
+// RulesDelete deletes the filtered stream rules with the given IDs. If any
+// rule could not be deleted, an APIError listing them is returned.
+// https://developer.twitter.com/en/docs/twitter-api/tweets/filtered-stream/api-reference/post-tweets-search-stream-rules
+func (srv *StreamService) RulesDelete(ids []RuleID, params *StreamRulesDeleteParams) (*StreamRules, *http.Response, error) {
+	return srv.RulesDeleteWithContext(context.Background(), ids, params)
+}
+
+// RulesDeleteWithContext is like RulesDelete but uses the given context for the request.
+func (srv *StreamService) RulesDeleteWithContext(ctx context.Context, ids []RuleID, params *StreamRulesDeleteParams) (*StreamRules, *http.Response, error) {
+	body := &struct {
+		Delete struct {
+			IDs []RuleID `json:"ids"`
+		} `json:"delete"`
+	}{}
+	body.Delete.IDs = ids
+	return srv.postRules(ctx, body, params)
+}
+
+// RulesDeleteByValue deletes the filtered stream rules with the given
+// values. If any rule could not be deleted, an APIError listing them is
+// returned.
+// https://developer.twitter.com/en/docs/twitter-api/tweets/filtered-stream/api-reference/post-tweets-search-stream-rules
+func (srv *StreamService) RulesDeleteByValue(values []string, params *StreamRulesDeleteParams) (*StreamRules, *http.Response, error) {
+	return srv.RulesDeleteByValueWithContext(context.Background(), values, params)
+}
+
+// RulesDeleteByValueWithContext is like RulesDeleteByValue but uses the given context for the request.
+func (srv *StreamService) RulesDeleteByValueWithContext(ctx context.Context, values []string, params *StreamRulesDeleteParams) (*StreamRules, *http.Response, error) {
+	body := &struct {
+		Delete struct {
+			Values []string `json:"values"`
+		} `json:"delete"`
+	}{}
+	body.Delete.Values = values
+	return srv.postRules(ctx, body, params)
+}
+
+// RulesSync makes the live filtered stream rules match the given rules,
+// deleting live rules which are not desired and adding desired rules which
+// are not live. Rules are compared by value and tag, so changing a rule's
+// tag replaces the rule. The rules to add are validated with a dry run
+// before any live rule is deleted. Returns the response of the last request
+// made.
+func (srv *StreamService) RulesSync(rules []StreamRule) (*StreamRulesSync, *http.Response, error) {
+	return srv.RulesSyncWithContext(context.Background(), rules)
+}
+
+// RulesSyncWithContext is like RulesSync but uses the given context for the requests.
+func (srv *StreamService) RulesSyncWithContext(ctx context.Context, rules []StreamRule) (*StreamRulesSync, *http.Response, error) {
+	live, resp, err := srv.RulesGetWithContext(ctx, nil)
+	if err != nil {
+		return nil, resp, err
+	}
+	desired := make(map[StreamRule]bool)
+	for _, rule := range rules {
+		desired[StreamRule{Value: rule.Value, Tag: rule.Tag}] = true
+	}
+
+	sync := new(StreamRulesSync)
+	var stale []StreamRule
+	staleValues := make(map[string]bool)
+	for _, rule := range live.Rules {
+		key := StreamRule{Value: rule.Value, Tag: rule.Tag}
+		if desired[key] {
+			// already live, don't add it again
+			delete(desired, key)
+			sync.Unchanged = append(sync.Unchanged, rule)
+			continue
+		}
+		stale = append(stale, rule)
+		staleValues[rule.Value] = true
+	}
+	var missing, unvalidated []StreamRule
+	for _, rule := range rules {
+		if key := (StreamRule{Value: rule.Value, Tag: rule.Tag}); desired[key] {
+			delete(desired, key)
+			missing = append(missing, key)
+			// a rule whose tag changed is valid, and would be rejected by a
+			// dry run as a duplicate of the live rule it replaces
+			if !staleValues[key.Value] {
+				unvalidated = append(unvalidated, key)
+			}
+		}
+	}
+	// validate the missing rules before deleting anything, so rules which
+	// can't be added don't leave the stream with only some of its rules
+	if len(stale) > 0 && len(unvalidated) > 0 {
+		if _, resp, err = srv.RulesDryRunWithContext(ctx, unvalidated); err != nil {
+			return sync, resp, err
+		}
+	}
+	// delete before adding, since a rule whose tag changed has the same
+	// value as its replacement
+	if len(stale) > 0 {
+		ids := make([]RuleID, len(stale))
+		for i, rule := range stale {
+			ids[i] = rule.ID
+		}
+		if _, resp, err = srv.RulesDeleteWithContext(ctx, ids, nil); err != nil {
+			return sync, resp, err
+		}
+		sync.Deleted = stale
+	}
+	if len(missing) > 0 {
+		added, addResp, err := srv.RulesAddWithContext(ctx, missing, nil)
+		sync.Added = added.Rules
+		return sync, addResp, err
+	}
+	return sync, resp, nil
+}
+
+// postRules posts a rules add or delete request body, returning the rules
+// and an APIError for any partial errors.
+func (srv *StreamService) postRules(ctx context.Context, body interface{}, params interface{}) (*StreamRules, *http.Response, error) {
+	rules := new(StreamRules)
+	apiError := new(APIError)
+	resp, err := receive(ctx, srv.filteredStream.New().Post("stream/rules").QueryStruct(params).BodyJSON(body), rules, apiError)
+	if err := relevantError(resp, err, *apiError); err != nil {
+		return rules, resp, err
+	}
+	return rules, resp, partialError(resp, rules.Errors)
+}
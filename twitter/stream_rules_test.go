@@ -0,0 +1,163 @@
+package twitter
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// rulesServer is a stand-in for the filtered stream rules endpoint which
+// keeps the live rules and records the requests made to it.
+type rulesServer struct {
+	live     []StreamRule
+	nextID   int
+	invalid  string
+	requests []string
+}
+
+func (s *rulesServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	if r.Method == "GET" {
+		s.requests = append(s.requests, "get")
+		json.NewEncoder(w).Encode(StreamRules{Rules: s.live})
+		return
+	}
+	var body struct {
+		Add    []StreamRule `json:"add"`
+		Delete struct {
+			IDs []RuleID `json:"ids"`
+		} `json:"delete"`
+	}
+	json.NewDecoder(r.Body).Decode(&body)
+	dryRun := r.URL.Query().Get("dry_run") == "true"
+	if body.Add != nil {
+		var values []string
+		for _, rule := range body.Add {
+			values = append(values, rule.Value)
+		}
+		request := "add " + strings.Join(values, ",")
+		if dryRun {
+			request = "dry run " + strings.Join(values, ",")
+		}
+		s.requests = append(s.requests, request)
+		for _, rule := range body.Add {
+			if rule.Value == s.invalid {
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprintf(w, `{"errors": [{"title": "Invalid Rule", "value": %q}]}`, rule.Value)
+				return
+			}
+		}
+		if dryRun {
+			json.NewEncoder(w).Encode(StreamRules{})
+			return
+		}
+		added := StreamRules{}
+		for _, rule := range body.Add {
+			s.nextID++
+			rule.ID = RuleID(fmt.Sprint(s.nextID))
+			s.live = append(s.live, rule)
+			added.Rules = append(added.Rules, rule)
+		}
+		json.NewEncoder(w).Encode(added)
+		return
+	}
+	var ids []string
+	deleted := make(map[RuleID]bool)
+	for _, id := range body.Delete.IDs {
+		ids = append(ids, string(id))
+		deleted[id] = true
+	}
+	s.requests = append(s.requests, "delete "+strings.Join(ids, ","))
+	var live []StreamRule
+	for _, rule := range s.live {
+		if !deleted[rule.ID] {
+			live = append(live, rule)
+		}
+	}
+	s.live = live
+	json.NewEncoder(w).Encode(StreamRules{})
+}
+
+func TestStreamService_RulesSync(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+	rules := &rulesServer{
+		live: []StreamRule{
+			{ID: "1", Value: "golang"},
+			{ID: "2", Value: "gophers", Tag: "old"},
+			{ID: "3", Value: "rust"},
+		},
+		nextID: 3,
+	}
+	mux.Handle("/2/tweets/search/stream/rules", rules)
+
+	client := NewClient(httpClient)
+	sync, _, err := client.Streams.RulesSync([]StreamRule{
+		{Value: "golang"},
+		{Value: "gophers", Tag: "new"},
+		{Value: "zig"},
+	})
+	assert.Nil(t, err)
+	// the changed tag isn't dry run, since it duplicates a live rule value
+	assert.Equal(t, []string{"get", "dry run zig", "delete 2,3", "add gophers,zig"}, rules.requests)
+	assert.Equal(t, []StreamRule{{ID: "1", Value: "golang"}}, sync.Unchanged)
+	assert.Equal(t, []StreamRule{{ID: "2", Value: "gophers", Tag: "old"}, {ID: "3", Value: "rust"}}, sync.Deleted)
+	assert.Equal(t, []StreamRule{{ID: "4", Value: "gophers", Tag: "new"}, {ID: "5", Value: "zig"}}, sync.Added)
+	assert.Equal(t, []StreamRule{{ID: "1", Value: "golang"}, {ID: "4", Value: "gophers", Tag: "new"}, {ID: "5", Value: "zig"}}, rules.live)
+}
+
+func TestStreamService_RulesSyncUnchanged(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+	rules := &rulesServer{live: []StreamRule{{ID: "1", Value: "golang"}}}
+	mux.Handle("/2/tweets/search/stream/rules", rules)
+
+	client := NewClient(httpClient)
+	sync, _, err := client.Streams.RulesSync([]StreamRule{{Value: "golang"}})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"get"}, rules.requests)
+	assert.Equal(t, []StreamRule{{ID: "1", Value: "golang"}}, sync.Unchanged)
+	assert.Nil(t, sync.Deleted)
+	assert.Nil(t, sync.Added)
+}
+
+func TestStreamService_RulesSyncOnlyAdds(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+	rules := &rulesServer{}
+	mux.Handle("/2/tweets/search/stream/rules", rules)
+
+	client := NewClient(httpClient)
+	sync, _, err := client.Streams.RulesSync([]StreamRule{{Value: "golang"}})
+	assert.Nil(t, err)
+	// with nothing to delete, adding validates the rules itself
+	assert.Equal(t, []string{"get", "add golang"}, rules.requests)
+	assert.Equal(t, []StreamRule{{ID: "1", Value: "golang"}}, sync.Added)
+}
+
+func TestStreamService_RulesSyncInvalidRule(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+	rules := &rulesServer{
+		live:    []StreamRule{{ID: "1", Value: "golang"}},
+		nextID:  1,
+		invalid: "(",
+	}
+	mux.Handle("/2/tweets/search/stream/rules", rules)
+
+	client := NewClient(httpClient)
+	sync, resp, err := client.Streams.RulesSync([]StreamRule{{Value: "("}})
+	if assert.IsType(t, APIError{}, err) {
+		assert.Equal(t, http.StatusBadRequest, err.(APIError).StatusCode)
+	}
+	assert.Equal(t, 400, resp.StatusCode)
+	// nothing is deleted when the rules to add are invalid
+	assert.Equal(t, []string{"get", "dry run ("}, rules.requests)
+	assert.Nil(t, sync.Deleted)
+	assert.Nil(t, sync.Added)
+	assert.Equal(t, []StreamRule{{ID: "1", Value: "golang"}}, rules.live)
+}
@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"bytes"
 	"io"
+	"sync"
 	"time"
 )
 
@@ -36,6 +37,8 @@ func sleepOrDone(d time.Duration, done <-chan struct{}) {
 type streamResponseBodyReader struct {
 	reader *bufio.Reader
 	buf    bytes.Buffer
+	// recorder, if set, records each message read
+	recorder *StreamRecorder
 }
 
 // newStreamResponseBodyReader returns an instance of streamResponseBodyReader
@@ -91,5 +94,48 @@ func (r *streamResponseBodyReader) readNext() ([]byte, error) {
 	// Get the stream message bytes from buf. Not that Bytes() won't mark the
 	// returned data as "read", and we need to explicitly call Truncate(0) to
 	// discard from buf before writing the next stream message to buf.
+	if r.recorder != nil {
+		r.recorder.record(r.buf.Bytes(), time.Now())
+	}
 	return r.buf.Bytes(), nil
 }
+
+// idleTimeoutReader reads from a stream response body, closing the body if a
+// read waits for the timeout so that a read blocked on a stalled connection
+// returns. The timeout only runs while waiting in Read, so time a slow
+// consumer spends handling messages is not counted. The stalled channel is
+// closed when the body is.
+type idleTimeoutReader struct {
+	body    io.ReadCloser
+	timeout time.Duration
+	timer   *time.Timer
+	stall   sync.Once
+	stalled chan struct{}
+}
+
+// newIdleTimeoutReader returns an idleTimeoutReader for the body, whose
+// timeout starts with the first Read.
+func newIdleTimeoutReader(body io.ReadCloser, timeout time.Duration) *idleTimeoutReader {
+	r := &idleTimeoutReader{body: body, timeout: timeout, stalled: make(chan struct{})}
+	r.timer = time.AfterFunc(timeout, func() {
+		// a read after a stall may arm the timer again
+		r.stall.Do(func() {
+			close(r.stalled)
+			body.Close()
+		})
+	})
+	r.timer.Stop()
+	return r
+}
+
+// Read reads from the body, closing it if nothing arrives for the timeout.
+func (r *idleTimeoutReader) Read(p []byte) (int, error) {
+	r.timer.Reset(r.timeout)
+	defer r.timer.Stop()
+	return r.body.Read(p)
+}
+
+// stop stops the timeout.
+func (r *idleTimeoutReader) stop() {
+	r.timer.Stop()
+}
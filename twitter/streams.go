@@ -1,9 +1,13 @@
 package twitter
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"io"
+	"math"
 	"net/http"
+	"strconv"
 	"sync"
 	"time"
 
@@ -12,7 +16,6 @@ import (
 )
 
 const (
-	userAgent              = "go-twitter v0.1"
 	filteredStreamEndpoint = "tweets/search/"
 	sampledStreamEndpoint  = "tweets/sample/"
 )
@@ -26,108 +29,192 @@ type StreamService struct {
 
 // newStreamService returns a new StreamService.
 func newStreamService(client *http.Client, sling *sling.Sling) *StreamService {
-	sling.Set("User-Agent", userAgent)
 	return &StreamService{
 		client:         client,
-		filteredStream: sling.New().Base(twitterAPI).Path(filteredStreamEndpoint),
-		sampledStream:  sling.New().Base(twitterAPI).Path(sampledStreamEndpoint),
+		filteredStream: sling.New().Path(filteredStreamEndpoint),
+		sampledStream:  sling.New().Path(sampledStreamEndpoint),
 	}
 }
 
 // StreamFilterParams are parameters for StreamService.Filter.
 type StreamParams struct {
-	Expansions      []string `url:"expansions,omitempty,comma"`
-	MediaFields     []string `url:"media.fields,omitempty,comma"`
-	PlaceFields     []string `url:"place.fields,omitempty,comma"`
-	PollFields      []string `url:"poll.fields,omitempty,comma"`
-	TweetFields     []string `url:"tweet.fields,omitempty,comma"`
-	UserFields      []string `url:"user.fields,omitempty,comma"`
-	BackfillMinutes int      `url:"backfill_minutes,omitempty"`
+	FieldSet
+	// BackfillMinutes requests up to 5 minutes of Tweets missed before the
+	// stream first connects. Requires Academic Research access.
+	BackfillMinutes int `url:"backfill_minutes,omitempty"`
+	// BackfillOnReconnect requests the Tweets missed while reconnecting,
+	// since the last message or keep-alive was received, up to 5 minutes.
+	// Requires Academic Research access.
+	BackfillOnReconnect bool `url:"-"`
+	// Deduplicate drops Tweets which were already received, such as those
+	// delivered again by a backfill.
+	Deduplicate bool `url:"-"`
+	// Recorder, if set, records the raw messages received to be replayed
+	// by ReplayStream.
+	Recorder *StreamRecorder `url:"-"`
+	// OnEvent, if set, is called from the stream goroutine as the stream
+	// connects, disconnects, stalls, backs off, and fails. It must not block.
+	OnEvent func(StreamEvent) `url:"-"`
+	// StallTimeout is how long a connection may go without receiving data
+	// or a keep-alive before it is considered stalled, closed, and
+	// reconnected. Only time waiting on the connection counts, not time
+	// spent delivering messages to a slow consumer. Defaults to 20 seconds,
+	// the interval of Twitter's keep-alives, after which Twitter advises
+	// reconnecting. Negative disables stall detection.
+	StallTimeout time.Duration `url:"-"`
 }
 
+// defaultStallTimeout is the default StreamParams StallTimeout.
+const defaultStallTimeout = 20 * time.Second
+
+// maxBackfillMinutes is the most minutes of missed Tweets a stream may
+// backfill.
+const maxBackfillMinutes = 5
+
+// ErrStreamStalled is the reason a stream connection was closed when it
+// received nothing for the StreamParams StallTimeout.
+var ErrStreamStalled = errors.New("twitter: stream stalled")
+
 // Filter returns messages that match one or more filter predicates.
 // https://dev.twitter.com/streaming/reference/post/statuses/filter
 func (srv *StreamService) Filter(params *StreamParams) (*Stream, error) {
+	return srv.FilterWithContext(context.Background(), params)
+}
+
+// FilterWithContext is like Filter but stops the stream when ctx is done.
+func (srv *StreamService) FilterWithContext(ctx context.Context, params *StreamParams) (*Stream, error) {
+	if params == nil {
+		params = &StreamParams{}
+	}
+	if err := params.ValidateForTweets(); err != nil {
+		return nil, err
+	}
 	req, err := srv.filteredStream.New().Get("stream").QueryStruct(params).Request()
 	if err != nil {
 		return nil, err
 	}
-	return newStream(srv.client, req), nil
+	return newStream(ctx, srv.client, req, params), nil
 }
 
 // Sample returns a small sample of public stream messages.
 // https://dev.twitter.com/streaming/reference/get/statuses/sample
 func (srv *StreamService) Sample(params *StreamParams) (*Stream, error) {
+	return srv.SampleWithContext(context.Background(), params)
+}
+
+// SampleWithContext is like Sample but stops the stream when ctx is done.
+func (srv *StreamService) SampleWithContext(ctx context.Context, params *StreamParams) (*Stream, error) {
+	if params == nil {
+		params = &StreamParams{}
+	}
+	if err := params.ValidateForTweets(); err != nil {
+		return nil, err
+	}
 	req, err := srv.sampledStream.New().Get("stream").QueryStruct(params).Request()
 	if err != nil {
 		return nil, err
 	}
-	return newStream(srv.client, req), nil
+	return newStream(ctx, srv.client, req, params), nil
 }
 
 // Stream maintains a connection to the Twitter Streaming API, receives
 // messages from the streaming response, and sends them on the Messages
 // channel from a goroutine. The stream goroutine stops itself if an EOF is
-// reached or retry errors occur, also closing the Messages channel.
+// reached, retry errors occur, or the stream's context is done, also closing
+// the Messages channel.
+//
+// Messages are StreamMessages (e.g. *StreamData), v1.1 messages, or errors
+// connecting to the stream. MessagesOf receives messages of a single type.
 //
-// The client must Stop() the stream when finished receiving, which will
-// wait until the stream is properly stopped.
+// The client must Stop() the stream (or cancel its context) when finished
+// receiving. Stop will wait until the stream is properly stopped. If the
+// stream stopped itself, Err returns why.
 type Stream struct {
 	client   *http.Client
 	Messages chan interface{}
-	done     chan struct{}
+	ctx      context.Context
+	cancel   context.CancelFunc
 	group    *sync.WaitGroup
-	body     io.Closer
+	onEvent  func(StreamEvent)
+	// stallTimeout is zero if stall detection is disabled
+	stallTimeout        time.Duration
+	backfillOnReconnect bool
+	// lastReceived is the time the last message or keep-alive was received
+	lastReceived time.Time
+	// seen holds the IDs of recent Tweets received, if deduplicating
+	seen      map[TweetID]struct{}
+	lastPrune time.Time
+	recorder  *StreamRecorder
+	mu        sync.Mutex
+	err       error
 }
 
+// StreamData is a Tweet received on a v2 stream, along with its Includes,
+// the filtered stream rules it matched, and any partial errors.
 type StreamData struct {
 	Tweet         *Tweet          `json:"data,omitempty"`
 	Includes      *Includes       `json:"includes,omitempty"`
 	Attachments   *ExtendedEntity `json:"attachments,omitempty"`
-	MatchingRules []struct {
-		Id  string `json:"id,omitempty"`
-		Tag string `json:"tag,omitempty"`
-	} `json:"matching_rules,omitempty"`
+	MatchingRules []MatchingRule  `json:"matching_rules,omitempty"`
+	Errors        []ErrorDetail   `json:"errors,omitempty"`
 }
 
-// Includes represents the list of entities that a tweet includes, such as other tweets, users, media, places or polls.
-type Includes struct {
-	Tweets []*Tweet       `json:"tweets"`
-	Users  []*User        `json:"users"`
-	Media  []*MediaEntity `json:"media"`
-	Places []*Place       `json:"places"`
-	Polls  []*Poll        `json:"polls"`
+// MatchingRule identifies a filtered stream rule which matched a Tweet.
+type MatchingRule struct {
+	ID  RuleID `json:"id,omitempty"`
+	Tag string `json:"tag,omitempty"`
 }
 
 // newStream creates a Stream and starts a goroutine to retry connecting and
-// receive from a stream response. The goroutine may stop due to retry errors
-// or be stopped by calling Stop() on the stream.
-func newStream(client *http.Client, req *http.Request) *Stream {
+// receive from a stream response. The goroutine may stop due to retry errors,
+// be stopped by calling Stop() on the stream, or by ctx being done.
+func newStream(ctx context.Context, client *http.Client, req *http.Request, params *StreamParams) *Stream {
+	ctx, cancel := context.WithCancel(ctx)
 	s := &Stream{
 		client:   client,
 		Messages: make(chan interface{}),
-		done:     make(chan struct{}),
+		ctx:      ctx,
+		cancel:   cancel,
 		group:    &sync.WaitGroup{},
+		onEvent:  params.OnEvent,
+	}
+	switch {
+	case params.StallTimeout == 0:
+		s.stallTimeout = defaultStallTimeout
+	case params.StallTimeout > 0:
+		s.stallTimeout = params.StallTimeout
+	}
+	s.backfillOnReconnect = params.BackfillOnReconnect
+	s.recorder = params.Recorder
+	if params.Deduplicate {
+		s.seen = make(map[TweetID]struct{})
 	}
 	s.group.Add(1)
-	go s.retry(req, newExponentialBackOff(), newAggressiveExponentialBackOff())
+	go s.retry(req.WithContext(ctx), newExponentialBackOff(), newAggressiveExponentialBackOff())
 	return s
 }
 
 // Stop signals retry and receiver to stop, closes the Messages channel, and
 // blocks until done.
 func (s *Stream) Stop() {
-	close(s.done)
-	// Scanner does not have a Stop() or take a done channel, so for low volume
-	// streams Scan() blocks until the next keep-alive. Close the resp.Body to
-	// escape and stop the stream in a timely fashion.
-	if s.body != nil {
-		s.body.Close()
-	}
+	// The stream request is bound to the stream's context, so cancelling it
+	// also aborts a read blocked waiting for the next keep-alive on low volume
+	// streams, stopping the stream in a timely fashion.
+	s.cancel()
 	// block until the retry goroutine stops
 	s.group.Wait()
 }
 
+// Err returns the error which stopped the stream from reconnecting, such as
+// an APIError for a 401 Unauthorized or 409 TooManyConnections response, once
+// the Messages channel is closed. Returns nil while the stream is running or
+// if it was stopped by Stop or its context.
+func (s *Stream) Err() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.err
+}
+
 // retry retries making the given http.Request and receiving the response
 // according to the Twitter backoff policies. Callers should invoke in a
 // goroutine since backoffs sleep between retries.
@@ -136,88 +223,307 @@ func (s *Stream) retry(req *http.Request, expBackOff backoff.BackOff, aggExpBack
 	// close Messages channel and decrement the wait group counter
 	defer close(s.Messages)
 	defer s.group.Done()
+	defer s.cancel()
 
-	var wait time.Duration
-	for !stopped(s.done) {
-		resp, err := s.client.Do(req)
+	// stalled is true while reconnecting after a stall
+	stalled := false
+	for !stopped(s.ctx.Done()) {
+		s.event(StreamEvent{Type: StreamConnecting})
+		resp, err := s.client.Do(s.reconnectRequest(req))
 		if err != nil {
+			if stopped(s.ctx.Done()) {
+				// the error is only the result of the stream being stopped
+				return
+			}
+			if stalled {
+				// the network may still be down, so back off and retry
+				if !s.backOff(StreamEvent{Err: err}, expBackOff.NextBackOff()) {
+					return
+				}
+				continue
+			}
 			// stop retrying for HTTP protocol errors
-			s.Messages <- err
+			s.send(err)
+			s.fail(StreamEvent{Err: err})
 			return
 		}
 		// when err is nil, resp contains a non-nil Body which must be closed
 		defer resp.Body.Close()
-		s.body = resp.Body
+		var event StreamEvent
+		var wait time.Duration
 		switch resp.StatusCode {
 		case 200:
+			s.event(StreamEvent{Type: StreamConnected, StatusCode: resp.StatusCode})
 			// receive stream response Body, handles closing
-			s.receive(resp.Body)
+			err := s.receive(resp.Body)
+			if !stopped(s.ctx.Done()) {
+				if err == ErrStreamStalled {
+					s.event(StreamEvent{Type: StreamStalled, Err: err})
+				}
+				s.event(StreamEvent{Type: StreamDisconnected, Err: err})
+			}
+			// reset once the connection ends rather than when it starts, so
+			// the time a connection lasted doesn't count towards the
+			// backoff's MaxElapsedTime
 			expBackOff.Reset()
 			aggExpBackOff.Reset()
+			stalled = err == ErrStreamStalled
+			if stalled {
+				// back off in case the network is down
+				event = StreamEvent{Err: err}
+				wait = expBackOff.NextBackOff()
+			}
 		case 503:
 			// exponential backoff
+			event = rejection(resp)
 			wait = expBackOff.NextBackOff()
 		case 420, 429:
 			// aggressive exponential backoff
+			event = rejection(resp)
 			wait = aggExpBackOff.NextBackOff()
 		default:
 			// stop retrying for other response codes
+			s.fail(rejection(resp))
 			resp.Body.Close()
 			return
 		}
 		// close response before each retry
 		resp.Body.Close()
-		if wait == backoff.Stop {
+		if !s.backOff(event, wait) {
 			return
 		}
-		sleepOrDone(wait, s.done)
+	}
+}
+
+// backOff reports the event as backing off and waits to reconnect, or fails
+// the stream with the event if the backoff policy stopped retrying. Returns
+// false if the stream failed.
+func (s *Stream) backOff(event StreamEvent, wait time.Duration) bool {
+	if wait == backoff.Stop {
+		s.fail(event)
+		return false
+	}
+	if wait > 0 && !stopped(s.ctx.Done()) {
+		event.Type = StreamBackingOff
+		event.Wait = wait
+		s.event(event)
+	}
+	sleepOrDone(wait, s.ctx.Done())
+	return true
+}
+
+// reconnectRequest returns the request to connect with. Once a message has
+// been received, reconnects request the Tweets missed since, if backfilling
+// on reconnect, and otherwise don't repeat the initial backfill.
+func (s *Stream) reconnectRequest(req *http.Request) *http.Request {
+	if s.lastReceived.IsZero() {
+		// nothing was received, so the initial backfill is still missed
+		return req
+	}
+	reconnect := req.Clone(req.Context())
+	query := reconnect.URL.Query()
+	query.Del("backfill_minutes")
+	if s.backfillOnReconnect {
+		minutes := int(math.Ceil(time.Since(s.lastReceived).Minutes()))
+		if minutes < 1 {
+			minutes = 1
+		} else if minutes > maxBackfillMinutes {
+			minutes = maxBackfillMinutes
+		}
+		query.Set("backfill_minutes", strconv.Itoa(minutes))
+	}
+	reconnect.URL.RawQuery = query.Encode()
+	return reconnect
+}
+
+// duplicate returns true if the Tweet of the data was already received, if
+// deduplicating, and records it as received otherwise.
+func (s *Stream) duplicate(data *StreamData) bool {
+	if s.seen == nil || data.Tweet == nil || data.Tweet.ID == 0 {
+		return false
+	}
+	id := data.Tweet.ID
+	if _, ok := s.seen[id]; ok {
+		return true
+	}
+	s.seen[id] = struct{}{}
+	// a backfill only delivers Tweets posted in the last few minutes, so
+	// older Tweets can't be delivered again and are forgotten. Tweet IDs
+	// embed the time they were posted.
+	now := time.Now()
+	if now.Sub(s.lastPrune) > time.Minute {
+		horizon := now.Add(-2 * maxBackfillMinutes * time.Minute)
+		for seen := range s.seen {
+			if seen.Time().Before(horizon) {
+				delete(s.seen, seen)
+			}
+		}
+		s.lastPrune = now
+	}
+	return false
+}
+
+// event calls the OnEvent callback, if any.
+func (s *Stream) event(event StreamEvent) {
+	if s.onEvent != nil {
+		s.onEvent(event)
+	}
+}
+
+// fail records the error which stopped the stream for Err and reports the
+// failure.
+func (s *Stream) fail(event StreamEvent) {
+	if event.Err == nil {
+		event.Err = errors.New("twitter: stream reconnect attempts exhausted")
+	}
+	s.mu.Lock()
+	s.err = event.Err
+	s.mu.Unlock()
+	event.Type = StreamFailed
+	event.Wait = 0
+	s.event(event)
+}
+
+// maxRejectionBody is the most of a rejected stream response body read.
+const maxRejectionBody = 64 << 10
+
+// rejection returns an event describing a response which rejected a stream
+// connection, with an APIError decoded from its body.
+func rejection(resp *http.Response) StreamEvent {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxRejectionBody))
+	apiError := new(APIError)
+	json.Unmarshal(body, apiError)
+	return StreamEvent{
+		Err:        relevantError(resp, nil, *apiError),
+		StatusCode: resp.StatusCode,
+		Body:       body,
 	}
 }
 
 // receive scans a stream response body, JSON decodes tokens to messages, and
 // sends messages to the Messages channel. Receiving continues until an EOF,
-// scan error, or the done channel is closed.
-func (s *Stream) receive(body io.Reader) {
-	reader := newStreamResponseBodyReader(body)
-	for !stopped(s.done) {
+// scan error, or the stream's context is done. Returns why receiving
+// stopped: ErrStreamStalled if nothing was received for the stall timeout,
+// the StreamError by which Twitter announced a disconnect, if any, or else
+// the EOF, scan error, or context error.
+func (s *Stream) receive(body io.ReadCloser) error {
+	var reader *streamResponseBodyReader
+	stalled := make(chan struct{})
+	if s.stallTimeout > 0 {
+		idle := newIdleTimeoutReader(body, s.stallTimeout)
+		defer idle.stop()
+		reader = newStreamResponseBodyReader(idle)
+		stalled = idle.stalled
+	} else {
+		reader = newStreamResponseBodyReader(body)
+	}
+	reader.recorder = s.recorder
+	var disconnect error
+	for !stopped(s.ctx.Done()) {
 		data, err := reader.readNext()
 		if err != nil {
-			return
+			if stopped(stalled) {
+				return ErrStreamStalled
+			}
+			if disconnect != nil {
+				return disconnect
+			}
+			return err
 		}
+		s.lastReceived = time.Now()
 		if len(data) == 0 {
 			// empty keep-alive
+			if !s.send(&StreamKeepAlive{}) {
+				break
+			}
 			continue
 		}
-		select {
 		// send messages, data, or errors
-		case s.Messages <- getMessage(data):
+		message := getMessage(data)
+		if streamData, ok := message.(*StreamData); ok && s.duplicate(streamData) {
 			continue
-		// allow client to Stop(), even if not receiving
-		case <-s.done:
-			return
 		}
+		if streamError, ok := message.(*StreamError); ok && streamError.IsDisconnect() {
+			disconnect = streamError
+		}
+		if !s.send(message) {
+			break
+		}
+	}
+	return s.ctx.Err()
+}
+
+// send sends the message on the Messages channel, returning false without
+// sending if the stream's context is done first. This allows the client to
+// Stop(), even if not receiving.
+func (s *Stream) send(message interface{}) bool {
+	select {
+	case s.Messages <- message:
+		return true
+	case <-s.ctx.Done():
+		return false
 	}
 }
 
+// MessagesOf returns a channel receiving the stream's messages of type T,
+// such as StreamMessage for every v2 message or *StreamData for only Tweets,
+// to receive from in place of Messages. Messages of other types are dropped.
+// The channel is closed when the stream stops.
+//
+//	for data := range twitter.MessagesOf[*twitter.StreamData](stream) {
+//		fmt.Println(data.Tweet.Text)
+//	}
+func MessagesOf[T any](s *Stream) <-chan T {
+	typed := make(chan T)
+	go func() {
+		defer close(typed)
+		for message := range s.Messages {
+			m, ok := message.(T)
+			if !ok {
+				continue
+			}
+			select {
+			case typed <- m:
+			case <-s.ctx.Done():
+				return
+			}
+		}
+	}()
+	return typed
+}
+
 // getMessage unmarshals the token and returns a message struct, if the type
 // can be determined. Otherwise, returns the token unmarshalled into a data
-// map[string]interface{} or the unmarshal error.
+// map[string]interface{} or a StreamDecodeError.
 func getMessage(token []byte) interface{} {
 	var data map[string]interface{}
 	// unmarshal JSON encoded token into a map for
 	err := json.Unmarshal(token, &data)
 	if err != nil {
-		return err
+		return newStreamDecodeError(token, err)
 	}
 	return decodeMessage(token, data)
 }
 
 // decodeMessage determines the message type from known data keys, allocates
 // at most one message struct, and JSON decodes the token into the message.
-// Returns the message struct or the data map if the message type could not be
-// determined.
+// v2 messages are recognized first, then v1.1 messages. Returns the message
+// struct or the data map if the message type could not be determined.
 func decodeMessage(token []byte, data map[string]interface{}) interface{} {
-	if hasPath(data, "retweet_count") {
+	if hasPath(data, "data") || hasPath(data, "matching_rules") {
+		streamData := new(StreamData)
+		if err := json.Unmarshal(token, streamData); err != nil {
+			return newStreamDecodeError(token, err)
+		}
+		streamData.Includes.hydrate(streamData.Tweet)
+		return streamData
+	} else if hasPath(data, "errors") {
+		streamError := new(StreamError)
+		if err := json.Unmarshal(token, streamError); err != nil {
+			return newStreamDecodeError(token, err)
+		}
+		return streamError
+	} else if hasPath(data, "retweet_count") {
 		tweet := new(Tweet)
 		json.Unmarshal(token, tweet)
 		return tweet
@@ -261,10 +567,6 @@ func decodeMessage(token []byte, data map[string]interface{}) interface{} {
 		event := new(Event)
 		json.Unmarshal(token, event)
 		return event
-	} else if hasPath(data, "matching_rules") {
-		streamData := new(StreamData)
-		json.Unmarshal(token, streamData)
-		return streamData
 	}
 	// message type unknown, return the data map[string]interface{}
 	return data
@@ -0,0 +1,106 @@
+package twitter
+
+import (
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// keepAliveHandler writes a keep-alive every interval until the request is
+// done, counting the connections made.
+func keepAliveHandler(interval time.Duration, mu *sync.Mutex, connections *int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		*connections++
+		mu.Unlock()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			w.Write([]byte("\r\n"))
+			w.(http.Flusher).Flush()
+			select {
+			case <-ticker.C:
+			case <-r.Context().Done():
+				return
+			}
+		}
+	}
+}
+
+func TestStream_SlowConsumerNotStalled(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	var (
+		mu          sync.Mutex
+		connections int
+	)
+	mux.HandleFunc("/2/tweets/sample/stream", keepAliveHandler(100*time.Millisecond, &mu, &connections))
+
+	events := make(chan StreamEvent, 100)
+	client := NewClient(httpClient)
+	stream, err := client.Streams.Sample(&StreamParams{
+		StallTimeout: 300 * time.Millisecond,
+		OnEvent:      func(event StreamEvent) { events <- event },
+	})
+	assert.Nil(t, err)
+	assert.IsType(t, &StreamKeepAlive{}, <-stream.Messages)
+	// a consumer slower than the stall timeout leaves the connection idle
+	// while keep-alives are still arriving
+	time.Sleep(time.Second)
+	for i := 0; i < 3; i++ {
+		assert.IsType(t, &StreamKeepAlive{}, <-stream.Messages)
+	}
+	stream.Stop()
+	close(events)
+
+	mu.Lock()
+	assert.Equal(t, 1, connections)
+	mu.Unlock()
+	for event := range events {
+		assert.NotEqual(t, StreamStalled, event.Type)
+	}
+}
+
+func TestStream_Stalled(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	var (
+		mu          sync.Mutex
+		connections int
+	)
+	// a single keep-alive, then nothing until the connection is closed
+	mux.HandleFunc("/2/tweets/sample/stream", keepAliveHandler(time.Hour, &mu, &connections))
+
+	events := make(chan StreamEvent, 100)
+	client := NewClient(httpClient)
+	stream, err := client.Streams.Sample(&StreamParams{
+		StallTimeout: 100 * time.Millisecond,
+		OnEvent:      func(event StreamEvent) { events <- event },
+	})
+	assert.Nil(t, err)
+	go func() {
+		for range stream.Messages {
+		}
+	}()
+	var types []StreamEventType
+	timeout := time.After(5 * time.Second)
+	for len(types) == 0 || types[len(types)-1] != StreamBackingOff {
+		select {
+		case event := <-events:
+			types = append(types, event.Type)
+			if event.Type == StreamDisconnected {
+				assert.Equal(t, ErrStreamStalled, event.Err)
+			}
+		case <-timeout:
+			t.Fatalf("stream did not stall, events %v", types)
+		}
+	}
+	stream.Stop()
+	assert.Equal(t, []StreamEventType{StreamConnecting, StreamConnected, StreamStalled, StreamDisconnected, StreamBackingOff}, types)
+	assert.Nil(t, stream.Err())
+}
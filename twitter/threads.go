@@ -0,0 +1,234 @@
+package twitter
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/carbonrook/go-twitter/text"
+)
+
+// ThreadParams are the parameters for TweetService.CreateThread.
+type ThreadParams struct {
+	// InReplyToTweetID, if set, continues an existing thread or
+	// conversation from the Tweet with the given id.
+	InReplyToTweetID TweetID
+	// Rollback deletes the parts already posted when a later part fails,
+	// so a thread is posted entirely or not at all.
+	Rollback bool
+}
+
+// ThreadError is returned by TweetService.CreateThread when a part of a
+// thread could not be posted.
+type ThreadError struct {
+	// Part is the index of the part which could not be posted.
+	Part int
+	// Err is the error posting the part.
+	Err error
+	// RollbackErr is the first error deleting the posted parts, if any.
+	RollbackErr error
+}
+
+func (e *ThreadError) Error() string {
+	if e.RollbackErr != nil {
+		return fmt.Sprintf("twitter: thread part %d: %v (rollback: %v)", e.Part, e.Err, e.RollbackErr)
+	}
+	return fmt.Sprintf("twitter: thread part %d: %v", e.Part, e.Err)
+}
+
+// Unwrap returns the error posting the part, so predicates such as
+// IsRateLimited apply to a ThreadError.
+func (e *ThreadError) Unwrap() error {
+	return e.Err
+}
+
+// SplitThread splits content into the parts of a thread, each short enough to
+// be a Tweet. Text is split between sentences and lines where possible, then
+// between words, and only splits words longer than a Tweet. The whitespace
+// within a part, such as blank lines between paragraphs, is kept as given.
+// Attach media or polls to the returned parts before posting them with
+// TweetService.CreateThread.
+func SplitThread(content string) []*TweetCreateParams {
+	var parts []*TweetCreateParams
+	for _, part := range splitText(content, text.DefaultConfig.MaxWeightedTweetLength) {
+		parts = append(parts, &TweetCreateParams{Text: part})
+	}
+	return parts
+}
+
+// segment is a sentence or word and the whitespace which preceded it.
+type segment struct {
+	text   string
+	before string
+}
+
+// splitText greedily packs the sentences of content into parts of at most max
+// weighted length, falling back to words and then characters for sentences
+// and words which don't fit. Segments in the same part are joined by the
+// whitespace which separated them in content.
+func splitText(content string, max int) []string {
+	var parts []string
+	var current string
+	add := func(s segment) {
+		if current == "" {
+			current = s.text
+		} else if joined := current + s.before + s.text; text.WeightedLength(joined) <= max {
+			current = joined
+		} else {
+			parts = append(parts, current)
+			current = s.text
+		}
+	}
+	for _, sentence := range sentences(content) {
+		if text.WeightedLength(sentence.text) <= max {
+			add(sentence)
+			continue
+		}
+		for i, word := range words(sentence.text) {
+			if i == 0 {
+				word.before = sentence.before
+			}
+			if text.WeightedLength(word.text) <= max {
+				add(word)
+				continue
+			}
+			// a URL always fits, so this is a run without spaces (e.g. CJK)
+			for rest := word.text; rest != ""; {
+				head := text.Truncate(rest, max, "")
+				// a character heavier than max (e.g. an emoji when max is 1)
+				// goes in a part of its own rather than never fitting
+				for n := max + 1; head == ""; n++ {
+					head = text.Truncate(rest, n, "")
+				}
+				add(segment{text: head, before: word.before})
+				word.before = ""
+				rest = rest[len(head):]
+			}
+		}
+	}
+	if current != "" {
+		parts = append(parts, current)
+	}
+	return parts
+}
+
+// sentences splits content after sentence ending punctuation followed by
+// whitespace, and at the end of each line.
+func sentences(content string) []segment {
+	var segments []segment
+	start := 0
+	runes := []rune(content)
+	offset := 0
+	for i, r := range runes {
+		next := offset + utf8.RuneLen(r)
+		last := i+1 == len(runes)
+		sentenceEnd := strings.ContainsRune(".!?。！？", r) && (last || unicode.IsSpace(runes[i+1]))
+		// a line ends before its line break, so blank lines precede the next
+		lineEnd := !last && runes[i+1] == '\n' && !unicode.IsSpace(r)
+		if sentenceEnd || lineEnd {
+			segments = appendSegment(segments, content[start:next])
+			start = next
+		}
+		offset = next
+	}
+	return appendSegment(segments, content[start:])
+}
+
+// words splits s into the runs of text between whitespace.
+func words(s string) []segment {
+	var segments []segment
+	for s != "" {
+		rest := strings.TrimLeftFunc(s, unicode.IsSpace)
+		end := strings.IndexFunc(rest, unicode.IsSpace)
+		if end < 0 {
+			end = len(rest)
+		}
+		segments = appendSegment(segments, s[:len(s)-len(rest)+end])
+		s = rest[end:]
+	}
+	return segments
+}
+
+// appendSegment appends s split into its leading whitespace and its text,
+// unless s is blank.
+func appendSegment(segments []segment, s string) []segment {
+	rest := strings.TrimLeftFunc(s, unicode.IsSpace)
+	t := strings.TrimRightFunc(rest, unicode.IsSpace)
+	if t == "" {
+		return segments
+	}
+	return append(segments, segment{text: t, before: s[:len(s)-len(rest)]})
+}
+
+// CreateThread posts the parts as a thread, each part a reply to the
+// previous one. All parts are validated before any is posted. The Tweet a
+// part replies to is set by the thread, but the ExcludeReplyUserIDs of any
+// Reply set on a part are kept. Returns the posted Tweets, and if a part could
+// not be posted, a ThreadError. With params.Rollback, the posted parts are
+// then deleted and only those which could not be deleted are returned.
+// Requires a user auth context.
+func (s *TweetService) CreateThread(parts []*TweetCreateParams, params *ThreadParams) ([]*Tweet, *http.Response, error) {
+	return s.CreateThreadWithContext(context.Background(), parts, params)
+}
+
+// CreateThreadWithContext is like CreateThread but uses the given context for the requests.
+func (s *TweetService) CreateThreadWithContext(ctx context.Context, parts []*TweetCreateParams, params *ThreadParams) ([]*Tweet, *http.Response, error) {
+	if params == nil {
+		params = &ThreadParams{}
+	}
+	for i, part := range parts {
+		// the Tweet replied to is only known when posting
+		p := *part
+		p.Reply = nil
+		if err := p.Validate(); err != nil {
+			return nil, nil, &ThreadError{Part: i, Err: err}
+		}
+	}
+
+	var posted []*Tweet
+	var resp *http.Response
+	inReplyTo := params.InReplyToTweetID
+	for i, part := range parts {
+		p := *part
+		p.Reply = nil
+		if inReplyTo != 0 {
+			p.Reply = &TweetCreateReply{InReplyToTweetID: inReplyTo}
+			if part.Reply != nil {
+				p.Reply.ExcludeReplyUserIDs = part.Reply.ExcludeReplyUserIDs
+			}
+		}
+		tweet, partResp, err := s.CreateWithContext(ctx, p.Text, &p)
+		resp = partResp
+		if err != nil {
+			threadErr := &ThreadError{Part: i, Err: err}
+			if params.Rollback {
+				posted, threadErr.RollbackErr = s.rollback(posted)
+			}
+			return posted, resp, threadErr
+		}
+		posted = append(posted, tweet)
+		inReplyTo = tweet.ID
+	}
+	return posted, resp, nil
+}
+
+// rollback deletes the posted Tweets, newest first, returning those which
+// could not be deleted and the first error deleting them.
+func (s *TweetService) rollback(posted []*Tweet) ([]*Tweet, error) {
+	var remaining []*Tweet
+	var firstErr error
+	for i := len(posted) - 1; i >= 0; i-- {
+		// delete even if the thread's context is done, rather than leave a
+		// partial thread behind
+		if _, _, err := s.DeleteWithContext(context.Background(), posted[i].ID); err != nil {
+			remaining = append([]*Tweet{posted[i]}, remaining...)
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+	return remaining, firstErr
+}
@@ -0,0 +1,105 @@
+package twitter
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitThread(t *testing.T) {
+	content := "Gophers.\n\nThey dig tunnels. They eat roots.\n"
+	parts := SplitThread(content)
+	assert.Equal(t, []*TweetCreateParams{{Text: "Gophers.\n\nThey dig tunnels. They eat roots."}}, parts)
+}
+
+func TestSplitText(t *testing.T) {
+	cases := []struct {
+		content  string
+		max      int
+		expected []string
+	}{
+		{"", 10, nil},
+		{"  \n ", 10, nil},
+		// whitespace between segments in a part is kept as given
+		{"Title\n\nOne.  Two.\n\nThree is a much longer paragraph", 20, []string{"Title\n\nOne.  Two.", "Three is a much", "longer paragraph"}},
+		{"a line\nanother line\n\nlast", 12, []string{"a line", "another line", "last"}},
+		{"Sentences end here. And start\tagain.", 30, []string{"Sentences end here.", "And start\tagain."}},
+		// runs without spaces are split between characters
+		{"日本語です", 4, []string{"日本", "語で", "す"}},
+		// characters heavier than a part go in a part of their own
+		{"日本", 1, []string{"日", "本"}},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.expected, splitText(c.content, c.max), c.content)
+	}
+}
+
+func TestTweetService_CreateThread(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	var bodies []string
+	mux.HandleFunc("/2/tweets", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		body, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"data": {"id": "%d", "text": "part"}}`, 20+len(bodies))
+	})
+
+	client := NewClient(httpClient)
+	parts := []*TweetCreateParams{
+		{Text: "one", Reply: &TweetCreateReply{ExcludeReplyUserIDs: []UserID{6253282}}},
+		{Text: "two", Reply: &TweetCreateReply{InReplyToTweetID: 99, ExcludeReplyUserIDs: []UserID{783214}}},
+		{Text: "three"},
+	}
+	tweets, _, err := client.Tweets.CreateThread(parts, &ThreadParams{InReplyToTweetID: 10})
+	assert.Nil(t, err)
+	assert.Equal(t, []*Tweet{{ID: 21, Text: "part"}, {ID: 22, Text: "part"}, {ID: 23, Text: "part"}}, tweets)
+	assert.Equal(t, []string{
+		`{"text":"one","reply":{"in_reply_to_tweet_id":"10","exclude_reply_user_ids":["6253282"]}}` + "\n",
+		`{"text":"two","reply":{"in_reply_to_tweet_id":"21","exclude_reply_user_ids":["783214"]}}` + "\n",
+		`{"text":"three","reply":{"in_reply_to_tweet_id":"22"}}` + "\n",
+	}, bodies)
+	// the caller's parts are left as given
+	assert.Equal(t, &TweetCreateReply{ExcludeReplyUserIDs: []UserID{6253282}}, parts[0].Reply)
+}
+
+func TestTweetService_CreateThreadRollback(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	var created int
+	mux.HandleFunc("/2/tweets", func(w http.ResponseWriter, r *http.Request) {
+		created++
+		w.Header().Set("Content-Type", "application/json")
+		if created == 2 {
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprintf(w, `{"title": "Forbidden", "detail": "duplicate content", "status": 403}`)
+			return
+		}
+		fmt.Fprintf(w, `{"data": {"id": "%d", "text": "part"}}`, 20+created)
+	})
+	var deleted []string
+	mux.HandleFunc("/2/tweets/", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "DELETE", r)
+		deleted = append(deleted, r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"data": {"deleted": true}}`)
+	})
+
+	client := NewClient(httpClient)
+	parts := SplitThread("One. Two.")
+	parts = append(parts, &TweetCreateParams{Text: "three"})
+	tweets, _, err := client.Tweets.CreateThread(parts, &ThreadParams{Rollback: true})
+	var threadErr *ThreadError
+	if assert.ErrorAs(t, err, &threadErr) {
+		assert.Equal(t, 1, threadErr.Part)
+		assert.Nil(t, threadErr.RollbackErr)
+	}
+	assert.Empty(t, tweets)
+	assert.Equal(t, []string{"/2/tweets/21"}, deleted)
+}
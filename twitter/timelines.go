@@ -1,7 +1,9 @@
 package twitter
 
 import (
+	"context"
 	"net/http"
+	"time"
 
 	"github.com/dghubble/sling"
 )
@@ -9,48 +11,88 @@ import (
 // TimelineService provides methods for accessing Twitter status timeline
 // API endpoints.
 type TimelineService struct {
-	sling *sling.Sling
+	baseSling *sling.Sling
+	sling     *sling.Sling
 }
 
 // newTimelineService returns a new TimelineService.
 func newTimelineService(sling *sling.Sling) *TimelineService {
 	return &TimelineService{
-		sling: sling.Path("statuses/"),
+		baseSling: sling.New(),
+		sling:     sling.Path("statuses/"),
 	}
 }
 
+// TweetTimeline is a page of a v2 Tweet timeline.
+type TweetTimeline struct {
+	Tweets   []*Tweet           `json:"data"`
+	Includes *Includes          `json:"includes"`
+	Meta     *TweetTimelineMeta `json:"meta"`
+	Errors   []ErrorDetail      `json:"errors"`
+}
+
+// TweetTimelineMeta describes a TweetTimeline page.
+type TweetTimelineMeta struct {
+	NewestID      TweetID `json:"newest_id"`
+	OldestID      TweetID `json:"oldest_id"`
+	ResultCount   int     `json:"result_count"`
+	NextToken     string  `json:"next_token"`
+	PreviousToken string  `json:"previous_token"`
+}
+
 // UserTimelineParams are the parameters for TimelineService.UserTimeline.
 type UserTimelineParams struct {
-	UserID          int64  `url:"user_id,omitempty"`
-	ScreenName      string `url:"screen_name,omitempty"`
-	Count           int    `url:"count,omitempty"`
-	SinceID         int64  `url:"since_id,omitempty"`
-	MaxID           int64  `url:"max_id,omitempty"`
-	TrimUser        *bool  `url:"trim_user,omitempty"`
-	ExcludeReplies  *bool  `url:"exclude_replies,omitempty"`
-	IncludeRetweets *bool  `url:"include_rts,omitempty"`
-	TweetMode       string `url:"tweet_mode,omitempty"`
+	UserID          UserID  `url:"user_id,omitempty"`
+	ScreenName      string  `url:"screen_name,omitempty"`
+	Count           int     `url:"count,omitempty"`
+	SinceID         TweetID `url:"since_id,omitempty"`
+	MaxID           TweetID `url:"max_id,omitempty"`
+	TrimUser        *bool   `url:"trim_user,omitempty"`
+	ExcludeReplies  *bool   `url:"exclude_replies,omitempty"`
+	IncludeRetweets *bool   `url:"include_rts,omitempty"`
+	TweetMode       string  `url:"tweet_mode,omitempty"`
 }
 
 // UserTimeline returns recent Tweets from the specified user.
 // https://dev.twitter.com/rest/reference/get/statuses/user_timeline
 func (s *TimelineService) UserTimeline(params *UserTimelineParams) ([]Tweet, *http.Response, error) {
+	return s.UserTimelineWithContext(context.Background(), params)
+}
+
+// UserTimelineWithContext is like UserTimeline but uses the given context for the request.
+func (s *TimelineService) UserTimelineWithContext(ctx context.Context, params *UserTimelineParams) ([]Tweet, *http.Response, error) {
 	tweets := new([]Tweet)
 	apiError := new(APIError)
-	resp, err := s.sling.New().Get("user_timeline.json").QueryStruct(params).Receive(tweets, apiError)
-	return *tweets, resp, relevantError(err, *apiError)
+	resp, err := receive(ctx, s.sling.New().Get("user_timeline.json").QueryStruct(params), tweets, apiError)
+	return *tweets, resp, relevantError(resp, err, *apiError)
+}
+
+// UserTimelinePaginator returns a Paginator over the Tweets returned by
+// UserTimeline, fetching older pages with max_id as needed.
+func (s *TimelineService) UserTimelinePaginator(ctx context.Context, params *UserTimelineParams) *Paginator[Tweet] {
+	p := UserTimelineParams{}
+	if params != nil {
+		p = *params
+	}
+	return NewMaxIDPaginator(ctx, func(ctx context.Context, maxID TweetID) ([]Tweet, *http.Response, error) {
+		if maxID != 0 {
+			p.MaxID = maxID
+		}
+		page, resp, err := s.UserTimelineWithContext(ctx, &p)
+		return page, resp, err
+	}, tweetID)
 }
 
 // HomeTimelineParams are the parameters for TimelineService.HomeTimeline.
 type HomeTimelineParams struct {
-	Count              int    `url:"count,omitempty"`
-	SinceID            int64  `url:"since_id,omitempty"`
-	MaxID              int64  `url:"max_id,omitempty"`
-	TrimUser           *bool  `url:"trim_user,omitempty"`
-	ExcludeReplies     *bool  `url:"exclude_replies,omitempty"`
-	ContributorDetails *bool  `url:"contributor_details,omitempty"`
-	IncludeEntities    *bool  `url:"include_entities,omitempty"`
-	TweetMode          string `url:"tweet_mode,omitempty"`
+	Count              int     `url:"count,omitempty"`
+	SinceID            TweetID `url:"since_id,omitempty"`
+	MaxID              TweetID `url:"max_id,omitempty"`
+	TrimUser           *bool   `url:"trim_user,omitempty"`
+	ExcludeReplies     *bool   `url:"exclude_replies,omitempty"`
+	ContributorDetails *bool   `url:"contributor_details,omitempty"`
+	IncludeEntities    *bool   `url:"include_entities,omitempty"`
+	TweetMode          string  `url:"tweet_mode,omitempty"`
 }
 
 // HomeTimeline returns recent Tweets and retweets from the user and those
@@ -58,43 +100,85 @@ type HomeTimelineParams struct {
 // Requires a user auth context.
 // https://dev.twitter.com/rest/reference/get/statuses/home_timeline
 func (s *TimelineService) HomeTimeline(params *HomeTimelineParams) ([]Tweet, *http.Response, error) {
+	return s.HomeTimelineWithContext(context.Background(), params)
+}
+
+// HomeTimelineWithContext is like HomeTimeline but uses the given context for the request.
+func (s *TimelineService) HomeTimelineWithContext(ctx context.Context, params *HomeTimelineParams) ([]Tweet, *http.Response, error) {
 	tweets := new([]Tweet)
 	apiError := new(APIError)
-	resp, err := s.sling.New().Get("home_timeline.json").QueryStruct(params).Receive(tweets, apiError)
-	return *tweets, resp, relevantError(err, *apiError)
+	resp, err := receive(ctx, s.sling.New().Get("home_timeline.json").QueryStruct(params), tweets, apiError)
+	return *tweets, resp, relevantError(resp, err, *apiError)
+}
+
+// HomeTimelinePaginator returns a Paginator over the Tweets returned by
+// HomeTimeline, fetching older pages with max_id as needed.
+func (s *TimelineService) HomeTimelinePaginator(ctx context.Context, params *HomeTimelineParams) *Paginator[Tweet] {
+	p := HomeTimelineParams{}
+	if params != nil {
+		p = *params
+	}
+	return NewMaxIDPaginator(ctx, func(ctx context.Context, maxID TweetID) ([]Tweet, *http.Response, error) {
+		if maxID != 0 {
+			p.MaxID = maxID
+		}
+		page, resp, err := s.HomeTimelineWithContext(ctx, &p)
+		return page, resp, err
+	}, tweetID)
 }
 
 // MentionTimelineParams are the parameters for TimelineService.MentionTimeline.
 type MentionTimelineParams struct {
-	Count              int    `url:"count,omitempty"`
-	SinceID            int64  `url:"since_id,omitempty"`
-	MaxID              int64  `url:"max_id,omitempty"`
-	TrimUser           *bool  `url:"trim_user,omitempty"`
-	ContributorDetails *bool  `url:"contributor_details,omitempty"`
-	IncludeEntities    *bool  `url:"include_entities,omitempty"`
-	TweetMode          string `url:"tweet_mode,omitempty"`
+	Count              int     `url:"count,omitempty"`
+	SinceID            TweetID `url:"since_id,omitempty"`
+	MaxID              TweetID `url:"max_id,omitempty"`
+	TrimUser           *bool   `url:"trim_user,omitempty"`
+	ContributorDetails *bool   `url:"contributor_details,omitempty"`
+	IncludeEntities    *bool   `url:"include_entities,omitempty"`
+	TweetMode          string  `url:"tweet_mode,omitempty"`
 }
 
 // MentionTimeline returns recent Tweet mentions of the authenticated user.
 // Requires a user auth context.
 // https://dev.twitter.com/rest/reference/get/statuses/mentions_timeline
 func (s *TimelineService) MentionTimeline(params *MentionTimelineParams) ([]Tweet, *http.Response, error) {
+	return s.MentionTimelineWithContext(context.Background(), params)
+}
+
+// MentionTimelineWithContext is like MentionTimeline but uses the given context for the request.
+func (s *TimelineService) MentionTimelineWithContext(ctx context.Context, params *MentionTimelineParams) ([]Tweet, *http.Response, error) {
 	tweets := new([]Tweet)
 	apiError := new(APIError)
-	resp, err := s.sling.New().Get("mentions_timeline.json").QueryStruct(params).Receive(tweets, apiError)
-	return *tweets, resp, relevantError(err, *apiError)
+	resp, err := receive(ctx, s.sling.New().Get("mentions_timeline.json").QueryStruct(params), tweets, apiError)
+	return *tweets, resp, relevantError(resp, err, *apiError)
+}
+
+// MentionTimelinePaginator returns a Paginator over the Tweets returned by
+// MentionTimeline, fetching older pages with max_id as needed.
+func (s *TimelineService) MentionTimelinePaginator(ctx context.Context, params *MentionTimelineParams) *Paginator[Tweet] {
+	p := MentionTimelineParams{}
+	if params != nil {
+		p = *params
+	}
+	return NewMaxIDPaginator(ctx, func(ctx context.Context, maxID TweetID) ([]Tweet, *http.Response, error) {
+		if maxID != 0 {
+			p.MaxID = maxID
+		}
+		page, resp, err := s.MentionTimelineWithContext(ctx, &p)
+		return page, resp, err
+	}, tweetID)
 }
 
 // RetweetsOfMeTimelineParams are the parameters for
 // TimelineService.RetweetsOfMeTimeline.
 type RetweetsOfMeTimelineParams struct {
-	Count               int    `url:"count,omitempty"`
-	SinceID             int64  `url:"since_id,omitempty"`
-	MaxID               int64  `url:"max_id,omitempty"`
-	TrimUser            *bool  `url:"trim_user,omitempty"`
-	IncludeEntities     *bool  `url:"include_entities,omitempty"`
-	IncludeUserEntities *bool  `url:"include_user_entities"`
-	TweetMode           string `url:"tweet_mode,omitempty"`
+	Count               int     `url:"count,omitempty"`
+	SinceID             TweetID `url:"since_id,omitempty"`
+	MaxID               TweetID `url:"max_id,omitempty"`
+	TrimUser            *bool   `url:"trim_user,omitempty"`
+	IncludeEntities     *bool   `url:"include_entities,omitempty"`
+	IncludeUserEntities *bool   `url:"include_user_entities"`
+	TweetMode           string  `url:"tweet_mode,omitempty"`
 }
 
 // RetweetsOfMeTimeline returns the most recent Tweets by the authenticated
@@ -102,8 +186,174 @@ type RetweetsOfMeTimelineParams struct {
 // Requires a user auth context.
 // https://dev.twitter.com/rest/reference/get/statuses/retweets_of_me
 func (s *TimelineService) RetweetsOfMeTimeline(params *RetweetsOfMeTimelineParams) ([]Tweet, *http.Response, error) {
+	return s.RetweetsOfMeTimelineWithContext(context.Background(), params)
+}
+
+// RetweetsOfMeTimelineWithContext is like RetweetsOfMeTimeline but uses the given context for the request.
+func (s *TimelineService) RetweetsOfMeTimelineWithContext(ctx context.Context, params *RetweetsOfMeTimelineParams) ([]Tweet, *http.Response, error) {
 	tweets := new([]Tweet)
 	apiError := new(APIError)
-	resp, err := s.sling.New().Get("retweets_of_me.json").QueryStruct(params).Receive(tweets, apiError)
-	return *tweets, resp, relevantError(err, *apiError)
+	resp, err := receive(ctx, s.sling.New().Get("retweets_of_me.json").QueryStruct(params), tweets, apiError)
+	return *tweets, resp, relevantError(resp, err, *apiError)
+}
+
+// RetweetsOfMeTimelinePaginator returns a Paginator over the Tweets returned by
+// RetweetsOfMeTimeline, fetching older pages with max_id as needed.
+func (s *TimelineService) RetweetsOfMeTimelinePaginator(ctx context.Context, params *RetweetsOfMeTimelineParams) *Paginator[Tweet] {
+	p := RetweetsOfMeTimelineParams{}
+	if params != nil {
+		p = *params
+	}
+	return NewMaxIDPaginator(ctx, func(ctx context.Context, maxID TweetID) ([]Tweet, *http.Response, error) {
+		if maxID != 0 {
+			p.MaxID = maxID
+		}
+		page, resp, err := s.RetweetsOfMeTimelineWithContext(ctx, &p)
+		return page, resp, err
+	}, tweetID)
+}
+
+// UserTweetsParams are the parameters for TimelineService.UserTweets.
+type UserTweetsParams struct {
+	StartTime       time.Time `url:"start_time,omitempty"`
+	EndTime         time.Time `url:"end_time,omitempty"`
+	SinceID         TweetID   `url:"since_id,omitempty"`
+	UntilID         TweetID   `url:"until_id,omitempty"`
+	Exclude         []string  `url:"exclude,omitempty,comma"`
+	MaxResults      int       `url:"max_results,omitempty"`
+	PaginationToken string    `url:"pagination_token,omitempty"`
+	FieldSet
+}
+
+// UserTweets returns Tweets posted by the user with the given id, newest
+// first. Exclude may contain "replies" and "retweets".
+// https://developer.twitter.com/en/docs/twitter-api/tweets/timelines/api-reference/get-users-id-tweets
+func (s *TimelineService) UserTweets(userID UserID, params *UserTweetsParams) (*TweetTimeline, *http.Response, error) {
+	return s.UserTweetsWithContext(context.Background(), userID, params)
+}
+
+// UserTweetsWithContext is like UserTweets but uses the given context for the request.
+func (s *TimelineService) UserTweetsWithContext(ctx context.Context, userID UserID, params *UserTweetsParams) (*TweetTimeline, *http.Response, error) {
+	if params == nil {
+		params = &UserTweetsParams{}
+	}
+	return s.timeline(ctx, "users/"+userID.String()+"/tweets", params)
+}
+
+// UserTweetsPaginator returns a Paginator over the Tweets returned by
+// UserTweets, fetching pages with pagination_token as needed.
+func (s *TimelineService) UserTweetsPaginator(ctx context.Context, userID UserID, params *UserTweetsParams) *Paginator[*Tweet] {
+	p := UserTweetsParams{}
+	if params != nil {
+		p = *params
+	}
+	return NewTokenPaginator(ctx, func(ctx context.Context, token string) ([]*Tweet, string, *http.Response, error) {
+		p.PaginationToken = token
+		return nextTimelinePage(s.UserTweetsWithContext(ctx, userID, &p))
+	})
+}
+
+// UserMentionsParams are the parameters for TimelineService.UserMentions.
+type UserMentionsParams struct {
+	StartTime       time.Time `url:"start_time,omitempty"`
+	EndTime         time.Time `url:"end_time,omitempty"`
+	SinceID         TweetID   `url:"since_id,omitempty"`
+	UntilID         TweetID   `url:"until_id,omitempty"`
+	MaxResults      int       `url:"max_results,omitempty"`
+	PaginationToken string    `url:"pagination_token,omitempty"`
+	FieldSet
+}
+
+// UserMentions returns Tweets mentioning the user with the given id, newest
+// first.
+// https://developer.twitter.com/en/docs/twitter-api/tweets/timelines/api-reference/get-users-id-mentions
+func (s *TimelineService) UserMentions(userID UserID, params *UserMentionsParams) (*TweetTimeline, *http.Response, error) {
+	return s.UserMentionsWithContext(context.Background(), userID, params)
+}
+
+// UserMentionsWithContext is like UserMentions but uses the given context for the request.
+func (s *TimelineService) UserMentionsWithContext(ctx context.Context, userID UserID, params *UserMentionsParams) (*TweetTimeline, *http.Response, error) {
+	if params == nil {
+		params = &UserMentionsParams{}
+	}
+	return s.timeline(ctx, "users/"+userID.String()+"/mentions", params)
+}
+
+// UserMentionsPaginator returns a Paginator over the Tweets returned by
+// UserMentions, fetching pages with pagination_token as needed.
+func (s *TimelineService) UserMentionsPaginator(ctx context.Context, userID UserID, params *UserMentionsParams) *Paginator[*Tweet] {
+	p := UserMentionsParams{}
+	if params != nil {
+		p = *params
+	}
+	return NewTokenPaginator(ctx, func(ctx context.Context, token string) ([]*Tweet, string, *http.Response, error) {
+		p.PaginationToken = token
+		return nextTimelinePage(s.UserMentionsWithContext(ctx, userID, &p))
+	})
+}
+
+// ReverseChronologicalHomeParams are the parameters for
+// TimelineService.ReverseChronologicalHome.
+type ReverseChronologicalHomeParams struct {
+	StartTime       time.Time `url:"start_time,omitempty"`
+	EndTime         time.Time `url:"end_time,omitempty"`
+	SinceID         TweetID   `url:"since_id,omitempty"`
+	UntilID         TweetID   `url:"until_id,omitempty"`
+	Exclude         []string  `url:"exclude,omitempty,comma"`
+	MaxResults      int       `url:"max_results,omitempty"`
+	PaginationToken string    `url:"pagination_token,omitempty"`
+	FieldSet
+}
+
+// ReverseChronologicalHome returns Tweets and retweets posted by the user
+// with the given id and the users they follow, newest first. Exclude may
+// contain "replies" and "retweets".
+// Requires a user auth context for the user with the given id.
+// https://developer.twitter.com/en/docs/twitter-api/tweets/timelines/api-reference/get-users-id-reverse-chronological
+func (s *TimelineService) ReverseChronologicalHome(userID UserID, params *ReverseChronologicalHomeParams) (*TweetTimeline, *http.Response, error) {
+	return s.ReverseChronologicalHomeWithContext(context.Background(), userID, params)
+}
+
+// ReverseChronologicalHomeWithContext is like ReverseChronologicalHome but uses the given context for the request.
+func (s *TimelineService) ReverseChronologicalHomeWithContext(ctx context.Context, userID UserID, params *ReverseChronologicalHomeParams) (*TweetTimeline, *http.Response, error) {
+	if params == nil {
+		params = &ReverseChronologicalHomeParams{}
+	}
+	return s.timeline(ctx, "users/"+userID.String()+"/timelines/reverse_chronological", params)
+}
+
+// ReverseChronologicalHomePaginator returns a Paginator over the Tweets
+// returned by ReverseChronologicalHome, fetching pages with
+// pagination_token as needed.
+func (s *TimelineService) ReverseChronologicalHomePaginator(ctx context.Context, userID UserID, params *ReverseChronologicalHomeParams) *Paginator[*Tweet] {
+	p := ReverseChronologicalHomeParams{}
+	if params != nil {
+		p = *params
+	}
+	return NewTokenPaginator(ctx, func(ctx context.Context, token string) ([]*Tweet, string, *http.Response, error) {
+		p.PaginationToken = token
+		return nextTimelinePage(s.ReverseChronologicalHomeWithContext(ctx, userID, &p))
+	})
+}
+
+// timeline requests the v2 timeline endpoint at the given path, validating
+// the fields of the params first.
+func (s *TimelineService) timeline(ctx context.Context, path string, params interface{ ValidateForTweets() error }) (*TweetTimeline, *http.Response, error) {
+	timeline := new(TweetTimeline)
+	if err := params.ValidateForTweets(); err != nil {
+		return timeline, nil, err
+	}
+	apiError := new(APIError)
+	resp, err := receive(ctx, s.baseSling.New().Get(path).QueryStruct(params), timeline, apiError)
+	timeline.Includes.hydrate(timeline.Tweets...)
+	return timeline, resp, relevantError(resp, err, *apiError)
+}
+
+// nextTimelinePage returns the Tweets and next token of a TweetTimeline page
+// for a Paginator.
+func nextTimelinePage(timeline *TweetTimeline, resp *http.Response, err error) ([]*Tweet, string, *http.Response, error) {
+	if timeline.Meta == nil {
+		return timeline.Tweets, "", resp, err
+	}
+	return timeline.Tweets, timeline.Meta.NextToken, resp, err
 }
@@ -0,0 +1,68 @@
+package twitter
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// timestampLayout is the RFC 3339 layout of v2 times, with milliseconds.
+const timestampLayout = "2006-01-02T15:04:05.000Z07:00"
+
+// Timestamp is a time in a Twitter model. Twitter encodes times as RFC 3339
+// (v2), as time.RubyDate (v1.1), or as Unix milliseconds (Direct Message
+// events); a Timestamp decodes from any of them and encodes as RFC 3339.
+// Absent or empty times decode as the zero Timestamp, which encodes as null.
+type Timestamp struct {
+	time.Time
+}
+
+// MarshalJSON encodes the time as an RFC 3339 JSON string, or null if the
+// time is zero.
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+	return []byte(strconv.Quote(t.UTC().Format(timestampLayout))), nil
+}
+
+// UnmarshalJSON decodes the time from a JSON string in any of the formats
+// Twitter uses, or from a JSON number of Unix milliseconds.
+func (t *Timestamp) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*t = Timestamp{}
+		return nil
+	}
+	s := string(data)
+	if len(data) >= 2 && data[0] == '"' && data[len(data)-1] == '"' {
+		unquoted, err := strconv.Unquote(s)
+		if err != nil {
+			return fmt.Errorf("twitter: invalid timestamp %s", data)
+		}
+		s = unquoted
+	}
+	parsed, err := parseTimestamp(s)
+	if err != nil {
+		return err
+	}
+	*t = parsed
+	return nil
+}
+
+// parseTimestamp parses a time in RFC 3339, time.RubyDate, or Unix
+// milliseconds, treating the empty string as the zero Timestamp.
+func parseTimestamp(s string) (Timestamp, error) {
+	if s == "" {
+		return Timestamp{}, nil
+	}
+	if ms, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return Timestamp{time.UnixMilli(ms).UTC()}, nil
+	}
+	for _, layout := range []string{time.RFC3339, time.RubyDate} {
+		if parsed, err := time.Parse(layout, s); err == nil {
+			return Timestamp{parsed}, nil
+		}
+	}
+	return Timestamp{}, fmt.Errorf("twitter: invalid timestamp %q", s)
+}
@@ -1,6 +1,7 @@
 package twitter
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/dghubble/sling"
@@ -38,10 +39,15 @@ type Location struct {
 // Available returns the locations that Twitter has trending topic information for.
 // https://dev.twitter.com/rest/reference/get/trends/available
 func (s *TrendsService) Available() ([]Location, *http.Response, error) {
+	return s.AvailableWithContext(context.Background())
+}
+
+// AvailableWithContext is like Available but uses the given context for the request.
+func (s *TrendsService) AvailableWithContext(ctx context.Context) ([]Location, *http.Response, error) {
 	locations := new([]Location)
 	apiError := new(APIError)
-	resp, err := s.sling.New().Get("available.json").Receive(locations, apiError)
-	return *locations, resp, relevantError(err, *apiError)
+	resp, err := receive(ctx, s.sling.New().Get("available.json"), locations, apiError)
+	return *locations, resp, relevantError(resp, err, *apiError)
 }
 
 // Trend represents a twitter trend.
@@ -56,8 +62,8 @@ type Trend struct {
 // TrendsList represents a list of twitter trends.
 type TrendsList struct {
 	Trends    []Trend          `json:"trends"`
-	AsOf      string           `json:"as_of"`
-	CreatedAt string           `json:"created_at"`
+	AsOf      Timestamp        `json:"as_of"`
+	CreatedAt Timestamp        `json:"created_at"`
 	Locations []TrendsLocation `json:"locations"`
 }
 
@@ -76,14 +82,19 @@ type TrendsPlaceParams struct {
 // Place returns the top 50 trending topics for a specific WOEID.
 // https://dev.twitter.com/rest/reference/get/trends/place
 func (s *TrendsService) Place(woeid int64, params *TrendsPlaceParams) ([]TrendsList, *http.Response, error) {
+	return s.PlaceWithContext(context.Background(), woeid, params)
+}
+
+// PlaceWithContext is like Place but uses the given context for the request.
+func (s *TrendsService) PlaceWithContext(ctx context.Context, woeid int64, params *TrendsPlaceParams) ([]TrendsList, *http.Response, error) {
 	if params == nil {
 		params = &TrendsPlaceParams{}
 	}
 	trendsList := new([]TrendsList)
 	params.WOEID = woeid
 	apiError := new(APIError)
-	resp, err := s.sling.New().Get("place.json").QueryStruct(params).Receive(trendsList, apiError)
-	return *trendsList, resp, relevantError(err, *apiError)
+	resp, err := receive(ctx, s.sling.New().Get("place.json").QueryStruct(params), trendsList, apiError)
+	return *trendsList, resp, relevantError(resp, err, *apiError)
 }
 
 // ClosestParams are the parameters for Trends.Closest.
@@ -95,8 +106,13 @@ type ClosestParams struct {
 // Closest returns the locations that Twitter has trending topic information for, closest to a specified location.
 // https://dev.twitter.com/rest/reference/get/trends/closest
 func (s *TrendsService) Closest(params *ClosestParams) ([]Location, *http.Response, error) {
+	return s.ClosestWithContext(context.Background(), params)
+}
+
+// ClosestWithContext is like Closest but uses the given context for the request.
+func (s *TrendsService) ClosestWithContext(ctx context.Context, params *ClosestParams) ([]Location, *http.Response, error) {
 	locations := new([]Location)
 	apiError := new(APIError)
-	resp, err := s.sling.New().Get("closest.json").QueryStruct(params).Receive(locations, apiError)
-	return *locations, resp, relevantError(err, *apiError)
+	resp, err := receive(ctx, s.sling.New().Get("closest.json").QueryStruct(params), locations, apiError)
+	return *locations, resp, relevantError(resp, err, *apiError)
 }
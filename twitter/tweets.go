@@ -0,0 +1,259 @@
+package twitter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"unicode/utf8"
+
+	"github.com/carbonrook/go-twitter/text"
+	"github.com/dghubble/sling"
+)
+
+// TweetService provides methods for accessing Twitter v2 Tweet API
+// endpoints.
+type TweetService struct {
+	sling *sling.Sling
+}
+
+// newTweetService returns a new TweetService.
+func newTweetService(sling *sling.Sling) *TweetService {
+	return &TweetService{
+		sling: sling,
+	}
+}
+
+// TweetLookup is the response of TweetService.Lookup. Errors lists the
+// requested Tweets which could not be returned (e.g. deleted Tweets).
+type TweetLookup struct {
+	Tweets   []*Tweet      `json:"data"`
+	Includes *Includes     `json:"includes"`
+	Errors   []ErrorDetail `json:"errors"`
+}
+
+// TweetLookupByID is the response of TweetService.LookupByID.
+type TweetLookupByID struct {
+	Tweet    *Tweet        `json:"data"`
+	Includes *Includes     `json:"includes"`
+	Errors   []ErrorDetail `json:"errors"`
+}
+
+// TweetLookupParams are the parameters for TweetService.Lookup and
+// TweetService.LookupByID.
+type TweetLookupParams struct {
+	IDs []TweetID `url:"ids,omitempty,comma"`
+	FieldSet
+}
+
+// Lookup returns the Tweets with the given ids, combined with any ids in
+// params.IDs. Tweets which could not be returned are listed in the Errors of
+// the response rather than returned as an error.
+// https://developer.twitter.com/en/docs/twitter-api/tweets/lookup/api-reference/get-tweets
+func (s *TweetService) Lookup(ids []TweetID, params *TweetLookupParams) (*TweetLookup, *http.Response, error) {
+	return s.LookupWithContext(context.Background(), ids, params)
+}
+
+// LookupWithContext is like Lookup but uses the given context for the request.
+func (s *TweetService) LookupWithContext(ctx context.Context, ids []TweetID, params *TweetLookupParams) (*TweetLookup, *http.Response, error) {
+	if params == nil {
+		params = &TweetLookupParams{}
+	}
+	lookup := new(TweetLookup)
+	if err := params.ValidateForTweets(); err != nil {
+		return lookup, nil, err
+	}
+	// copy the params, which may be reused, rather than add to their ids
+	p := *params
+	p.IDs = append(append([]TweetID(nil), params.IDs...), ids...)
+	apiError := new(APIError)
+	resp, err := receive(ctx, s.sling.New().Get("tweets").QueryStruct(&p), lookup, apiError)
+	lookup.Includes.hydrate(lookup.Tweets...)
+	return lookup, resp, relevantError(resp, err, *apiError)
+}
+
+// LookupByID returns the Tweet with the given id. If the Tweet could not be
+// returned, an APIError describing why is returned.
+// https://developer.twitter.com/en/docs/twitter-api/tweets/lookup/api-reference/get-tweets-id
+func (s *TweetService) LookupByID(id TweetID, params *TweetLookupParams) (*TweetLookupByID, *http.Response, error) {
+	return s.LookupByIDWithContext(context.Background(), id, params)
+}
+
+// LookupByIDWithContext is like LookupByID but uses the given context for the request.
+func (s *TweetService) LookupByIDWithContext(ctx context.Context, id TweetID, params *TweetLookupParams) (*TweetLookupByID, *http.Response, error) {
+	if params == nil {
+		params = &TweetLookupParams{}
+	}
+	lookup := new(TweetLookupByID)
+	if err := params.ValidateForTweets(); err != nil {
+		return lookup, nil, err
+	}
+	// ids is not a parameter of the single Tweet endpoint
+	p := *params
+	p.IDs = nil
+	apiError := new(APIError)
+	resp, err := receive(ctx, s.sling.New().Get("tweets/").Get(id.String()).QueryStruct(&p), lookup, apiError)
+	lookup.Includes.hydrate(lookup.Tweet)
+	if err := relevantError(resp, err, *apiError); err != nil {
+		return lookup, resp, err
+	}
+	if lookup.Tweet == nil {
+		return lookup, resp, partialError(resp, lookup.Errors)
+	}
+	return lookup, resp, nil
+}
+
+// Reply settings of a Tweet, which limit who may reply to it. By default
+// everyone may reply.
+const (
+	ReplySettingsMentionedUsers = "mentionedUsers"
+	ReplySettingsFollowing      = "following"
+)
+
+// TweetCreateParams are the parameters for TweetService.Create.
+// https://developer.twitter.com/en/docs/twitter-api/tweets/manage-tweets/api-reference/post-tweets
+type TweetCreateParams struct {
+	Text                  string            `json:"text,omitempty"`
+	DirectMessageDeepLink string            `json:"direct_message_deep_link,omitempty"`
+	ForSuperFollowersOnly bool              `json:"for_super_followers_only,omitempty"`
+	Geo                   *TweetCreateGeo   `json:"geo,omitempty"`
+	Media                 *TweetCreateMedia `json:"media,omitempty"`
+	Poll                  *TweetCreatePoll  `json:"poll,omitempty"`
+	QuoteTweetID          TweetID           `json:"quote_tweet_id,omitempty"`
+	Reply                 *TweetCreateReply `json:"reply,omitempty"`
+	ReplySettings         string            `json:"reply_settings,omitempty"`
+}
+
+// TweetCreateGeo tags a created Tweet with a place.
+type TweetCreateGeo struct {
+	PlaceID string `json:"place_id"`
+}
+
+// TweetCreateMedia attaches up to 4 uploaded media (see MediaService) to a
+// created Tweet, optionally tagging up to 10 users in them.
+type TweetCreateMedia struct {
+	MediaIDs      []MediaID `json:"media_ids"`
+	TaggedUserIDs []UserID  `json:"tagged_user_ids,omitempty"`
+}
+
+// TweetCreatePoll attaches a poll of 2 to 4 options of up to 25 characters
+// to a created Tweet, open for 5 to 10080 minutes (7 days).
+type TweetCreatePoll struct {
+	Options         []string `json:"options"`
+	DurationMinutes int      `json:"duration_minutes"`
+}
+
+// TweetCreateReply makes a created Tweet a reply to the Tweet with the given
+// id. Users mentioned in the conversation are mentioned in the reply, unless
+// their ids are excluded.
+type TweetCreateReply struct {
+	InReplyToTweetID    TweetID  `json:"in_reply_to_tweet_id"`
+	ExcludeReplyUserIDs []UserID `json:"exclude_reply_user_ids,omitempty"`
+}
+
+// Validate returns an error if Twitter would reject the params, such as a
+// Tweet with both a poll and media.
+func (p *TweetCreateParams) Validate() error {
+	if p.Text == "" && p.Media == nil && p.Poll == nil && p.QuoteTweetID == 0 && p.DirectMessageDeepLink == "" {
+		return errors.New("twitter: tweet requires text, media, a poll, or a quoted tweet")
+	}
+	if p.Text != "" {
+		if result := text.Parse(p.Text); !result.Valid {
+			return fmt.Errorf("twitter: tweet text is not valid, weighing %d of at most %d characters",
+				result.WeightedLength, text.DefaultConfig.MaxWeightedTweetLength)
+		}
+	}
+	exclusive := 0
+	for _, set := range []bool{p.Media != nil, p.Poll != nil, p.QuoteTweetID != 0} {
+		if set {
+			exclusive++
+		}
+	}
+	if exclusive > 1 {
+		return errors.New("twitter: tweet media, poll, and quoted tweet are mutually exclusive")
+	}
+	if p.Geo != nil && p.Geo.PlaceID == "" {
+		return errors.New("twitter: tweet geo requires a place id")
+	}
+	if media := p.Media; media != nil {
+		if len(media.MediaIDs) < 1 || len(media.MediaIDs) > 4 {
+			return fmt.Errorf("twitter: tweet media requires 1 to 4 media ids, got %d", len(media.MediaIDs))
+		}
+		if len(media.TaggedUserIDs) > 10 {
+			return fmt.Errorf("twitter: tweet media may tag up to 10 users, got %d", len(media.TaggedUserIDs))
+		}
+	}
+	if poll := p.Poll; poll != nil {
+		if len(poll.Options) < 2 || len(poll.Options) > 4 {
+			return fmt.Errorf("twitter: tweet poll requires 2 to 4 options, got %d", len(poll.Options))
+		}
+		for _, option := range poll.Options {
+			if n := utf8.RuneCountInString(option); n < 1 || n > 25 {
+				return fmt.Errorf("twitter: tweet poll option %q must be 1 to 25 characters", option)
+			}
+		}
+		if poll.DurationMinutes < 5 || poll.DurationMinutes > 10080 {
+			return fmt.Errorf("twitter: tweet poll duration must be 5 to 10080 minutes, got %d", poll.DurationMinutes)
+		}
+	}
+	if p.Reply != nil && p.Reply.InReplyToTweetID == 0 {
+		return errors.New("twitter: tweet reply requires an in reply to tweet id")
+	}
+	switch p.ReplySettings {
+	case "", ReplySettingsMentionedUsers, ReplySettingsFollowing:
+	default:
+		return fmt.Errorf("twitter: unknown tweet reply settings %q", p.ReplySettings)
+	}
+	return nil
+}
+
+// Create posts a Tweet with the given text, which may be empty if params
+// attach media, a poll, or a quoted Tweet. Params are validated before the
+// request is made. Returns the created Tweet's ID and Text.
+// Requires a user auth context.
+// https://developer.twitter.com/en/docs/twitter-api/tweets/manage-tweets/api-reference/post-tweets
+func (s *TweetService) Create(text string, params *TweetCreateParams) (*Tweet, *http.Response, error) {
+	return s.CreateWithContext(context.Background(), text, params)
+}
+
+// CreateWithContext is like Create but uses the given context for the request.
+func (s *TweetService) CreateWithContext(ctx context.Context, text string, params *TweetCreateParams) (*Tweet, *http.Response, error) {
+	if params == nil {
+		params = &TweetCreateParams{}
+	}
+	// set the text on a copy, leaving the caller's params as given
+	p := *params
+	p.Text = text
+	if err := p.Validate(); err != nil {
+		return new(Tweet), nil, err
+	}
+	created := &struct {
+		Tweet *Tweet `json:"data"`
+	}{}
+	apiError := new(APIError)
+	resp, err := receive(ctx, s.sling.New().Post("tweets").BodyJSON(&p), created, apiError)
+	if created.Tweet == nil {
+		created.Tweet = new(Tweet)
+	}
+	return created.Tweet, resp, relevantError(resp, err, *apiError)
+}
+
+// Delete deletes the Tweet with the given id, returning whether it was
+// deleted.
+// Requires a user auth context for the author of the Tweet.
+// https://developer.twitter.com/en/docs/twitter-api/tweets/manage-tweets/api-reference/delete-tweets-id
+func (s *TweetService) Delete(id TweetID) (bool, *http.Response, error) {
+	return s.DeleteWithContext(context.Background(), id)
+}
+
+// DeleteWithContext is like Delete but uses the given context for the request.
+func (s *TweetService) DeleteWithContext(ctx context.Context, id TweetID) (bool, *http.Response, error) {
+	deleted := &struct {
+		Data struct {
+			Deleted bool `json:"deleted"`
+		} `json:"data"`
+	}{}
+	apiError := new(APIError)
+	resp, err := receive(ctx, s.sling.New().Delete("tweets/").Delete(id.String()), deleted, apiError)
+	return deleted.Data.Deleted, resp, relevantError(resp, err, *apiError)
+}
@@ -0,0 +1,53 @@
+package twitter
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTweetService_Create(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	var bodies []string
+	mux.HandleFunc("/2/tweets", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "POST", r)
+		body, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"data": {"id": "20", "text": "just setting up my twttr"}}`)
+	})
+
+	client := NewClient(httpClient)
+	params := &TweetCreateParams{ReplySettings: ReplySettingsFollowing}
+	tweet, _, err := client.Tweets.Create("just setting up my twttr", params)
+	assert.Nil(t, err)
+	assert.Equal(t, &Tweet{ID: 20, Text: "just setting up my twttr"}, tweet)
+	_, _, err = client.Tweets.Create("second", params)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{
+		`{"text":"just setting up my twttr","reply_settings":"following"}` + "\n",
+		`{"text":"second","reply_settings":"following"}` + "\n",
+	}, bodies)
+	// the params may be reused for other Tweets
+	assert.Equal(t, &TweetCreateParams{ReplySettings: ReplySettingsFollowing}, params)
+}
+
+func TestTweetService_CreateInvalid(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/2/tweets", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request for an invalid Tweet")
+	})
+
+	client := NewClient(httpClient)
+	tweet, resp, err := client.Tweets.Create("", nil)
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+	assert.Equal(t, &Tweet{}, tweet)
+}
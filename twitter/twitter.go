@@ -1,17 +1,22 @@
 package twitter
 
 import (
-	"fmt"
+	"context"
 	"net/http"
 
 	"github.com/dghubble/sling"
 )
 
-const twitterAPI = "https://api.twitter.com/2/"
+const (
+	twitterAPI = "https://api.twitter.com/2/"
+	uploadAPI  = "https://upload.twitter.com/1.1/"
+	userAgent  = "go-twitter v0.1"
+)
 
 // Client is a Twitter client for making Twitter API requests.
 type Client struct {
 	sling *sling.Sling
+	rates *rateLimitTracker
 	// Twitter API Services
 	Accounts       *AccountService
 	DirectMessages *DirectMessageService
@@ -20,6 +25,7 @@ type Client struct {
 	Friends        *FriendService
 	Friendships    *FriendshipService
 	Lists          *ListsService
+	Media          *MediaService
 	RateLimits     *RateLimitService
 	Search         *SearchService
 	PremiumSearch  *PremiumSearchService
@@ -27,14 +33,31 @@ type Client struct {
 	Streams        *StreamService
 	Timelines      *TimelineService
 	Trends         *TrendsService
+	Tweets         *TweetService
 	Users          *UserService
 }
 
-func NewClientWithBearer(httpClient *http.Client, bearerToken string) *Client {
-	base := sling.New().Client(httpClient).Base(twitterAPI)
-	base.Set("Authorization", fmt.Sprintf("Bearer %s", bearerToken))
+// NewClient returns a new Client which makes requests with the given
+// http.Client, configured by any given Options.
+func NewClient(httpClient *http.Client, opts ...Option) *Client {
+	o := newClientOptions(opts)
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	rates := newRateLimitTracker(httpClient)
+	var doer sling.Doer = rates
+	if o.retryPolicy != nil {
+		doer = newRetrier(rates, *o.retryPolicy)
+	}
+	base := sling.New().Doer(doer).Base(o.baseURL)
+	for key, values := range o.header {
+		for _, value := range values {
+			base.Add(key, value)
+		}
+	}
 	return &Client{
 		sling:          base,
+		rates:          rates,
 		Accounts:       newAccountService(base.New()),
 		DirectMessages: newDirectMessageService(base.New()),
 		Favorites:      newFavoriteService(base.New()),
@@ -42,6 +65,7 @@ func NewClientWithBearer(httpClient *http.Client, bearerToken string) *Client {
 		Friends:        newFriendService(base.New()),
 		Friendships:    newFriendshipService(base.New()),
 		Lists:          newListService(base.New()),
+		Media:          newMediaService(base.New().Base(o.uploadURL)),
 		RateLimits:     newRateLimitService(base.New()),
 		Search:         newSearchService(base.New()),
 		PremiumSearch:  newPremiumSearchService(base.New()),
@@ -49,31 +73,40 @@ func NewClientWithBearer(httpClient *http.Client, bearerToken string) *Client {
 		Streams:        newStreamService(httpClient, base.New()),
 		Timelines:      newTimelineService(base.New()),
 		Trends:         newTrendsService(base.New()),
+		Tweets:         newTweetService(base.New()),
 		Users:          newUserService(base.New()),
 	}
 }
 
-// NewClient returns a new Client.
-func NewClient(httpClient *http.Client) *Client {
-	base := sling.New().Client(httpClient).Base(twitterAPI)
-	return &Client{
-		sling:          base,
-		Accounts:       newAccountService(base.New()),
-		DirectMessages: newDirectMessageService(base.New()),
-		Favorites:      newFavoriteService(base.New()),
-		Followers:      newFollowerService(base.New()),
-		Friends:        newFriendService(base.New()),
-		Friendships:    newFriendshipService(base.New()),
-		Lists:          newListService(base.New()),
-		RateLimits:     newRateLimitService(base.New()),
-		Search:         newSearchService(base.New()),
-		PremiumSearch:  newPremiumSearchService(base.New()),
-		Statuses:       newStatusService(base.New()),
-		Streams:        newStreamService(httpClient, base.New()),
-		Timelines:      newTimelineService(base.New()),
-		Trends:         newTrendsService(base.New()),
-		Users:          newUserService(base.New()),
+// Rate returns the rate limit state last reported by Twitter for the
+// endpoint with the given method and URL path (e.g. "GET", "/2/tweets/20"),
+// and false if no response from the endpoint carried rate limit headers.
+func (c *Client) Rate(method, path string) (Rate, bool) {
+	return c.rates.rate(method, path)
+}
+
+// Rates returns the rate limit state last reported by Twitter for every
+// endpoint requested so far, keyed like "GET /2/tweets/:id".
+func (c *Client) Rates() map[string]Rate {
+	return c.rates.snapshot()
+}
+
+// NewClientWithBearer returns a new Client which authorizes requests with
+// the given OAuth2 bearer token.
+//
+// Deprecated: use NewClient with the WithBearerToken Option.
+func NewClientWithBearer(httpClient *http.Client, bearerToken string) *Client {
+	return NewClient(httpClient, WithBearerToken(bearerToken))
+}
+
+// receive builds the request described by s, binds it to ctx, and decodes
+// the response into successV or failureV like sling.Receive.
+func receive(ctx context.Context, s *sling.Sling, successV, failureV interface{}) (*http.Response, error) {
+	req, err := s.Request()
+	if err != nil {
+		return nil, err
 	}
+	return s.Do(req.WithContext(ctx), successV, failureV)
 }
 
 // Bool returns a new pointer to the given bool value.
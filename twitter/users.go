@@ -1,7 +1,10 @@
 package twitter
 
 import (
+	"context"
 	"net/http"
+	"strings"
+	"sync"
 
 	"github.com/dghubble/sling"
 )
@@ -9,20 +12,22 @@ import (
 // User represents a Twitter User.
 // https://dev.twitter.com/overview/api/users
 type User struct {
-	ID              string            `json:"id"`
-	Name            string            `json:"name"`
-	Username        string            `json:"username"`
-	CreatedAt       string            `json:"created_at"`
-	Description     string            `json:"description"`
-	Entities        *UserEntities     `json:"entities"`
-	Location        string            `json:"location"`
-	PinnedTweetID   string            `json:"pinned_tweet_id"`
-	ProfileImageURL string            `json:"profile_image_url"`
-	Protected       bool              `json:"protected"`
-	PublicMetrics   UserPublicMetrics `json:"public_metrics"`
-	URL             string            `json:"url"`
-	Verified        bool              `json:"verified"`
-	Withheld        Withheld          `json:"withheld"`
+	ID                UserID            `json:"id"`
+	Name              string            `json:"name"`
+	Username          string            `json:"username"`
+	CreatedAt         Timestamp         `json:"created_at"`
+	Description       string            `json:"description"`
+	Entities          *UserEntities     `json:"entities"`
+	Location          string            `json:"location"`
+	MostRecentTweetID TweetID           `json:"most_recent_tweet_id"`
+	PinnedTweetID     TweetID           `json:"pinned_tweet_id"`
+	ProfileImageURL   string            `json:"profile_image_url"`
+	Protected         bool              `json:"protected"`
+	PublicMetrics     UserPublicMetrics `json:"public_metrics"`
+	URL               string            `json:"url"`
+	Verified          bool              `json:"verified"`
+	VerifiedType      string            `json:"verified_type"`
+	Withheld          Withheld          `json:"withheld"`
 }
 
 type UserPublicMetrics struct {
@@ -34,40 +39,247 @@ type UserPublicMetrics struct {
 
 // UserService provides methods for accessing Twitter user API endpoints.
 type UserService struct {
-	sling *sling.Sling
+	baseSling *sling.Sling
+	sling     *sling.Sling
 }
 
 // newUserService returns a new UserService.
 func newUserService(sling *sling.Sling) *UserService {
 	return &UserService{
-		sling: sling.Path("users/"),
+		baseSling: sling.New(),
+		sling:     sling.Path("users/"),
 	}
 }
 
-// UserShowParams are the parameters for UserService.Show.
+// UserServiceParams are the parameters for the UserService lookups. The
+// only expansion of a User is its pinned Tweet.
 type UserServiceParams struct {
-	UserFields  []string `url:"user.fields,omitempty"`
-	Expansions  []string `url:"expansions,omitempty"`
-	TweetFields []string `url:"tweet_fields,omitempty"`
+	FieldSet
 }
 
-func (s *UserService) UserByID(userid string, params *UserServiceParams) (*User, *http.Response, error) {
-	user := new(User)
-	apiError := new(APIError)
-	resp, err := s.sling.New().Get(userid).QueryStruct(params).Receive(user, apiError)
-	return user, resp, relevantError(err, *apiError)
+// UserByID returns the user with the given id.
+// https://developer.twitter.com/en/docs/twitter-api/users/lookup/api-reference/get-users-id
+func (s *UserService) UserByID(userid UserID, params *UserServiceParams) (*User, *http.Response, error) {
+	return s.UserByIDWithContext(context.Background(), userid, params)
+}
+
+// UserByIDWithContext is like UserByID but uses the given context for the request.
+func (s *UserService) UserByIDWithContext(ctx context.Context, userid UserID, params *UserServiceParams) (*User, *http.Response, error) {
+	return s.user(ctx, s.sling.New().Get(userid.String()).QueryStruct(params), params)
 }
 
+// UserByUsername returns the user with the given username.
+// https://developer.twitter.com/en/docs/twitter-api/users/lookup/api-reference/get-users-by-username-username
 func (s *UserService) UserByUsername(username string, params *UserServiceParams) (*User, *http.Response, error) {
-	user := new(User)
-	apiError := new(APIError)
-	resp, err := s.sling.New().Get("by/username/").Get(username).QueryStruct(params).Receive(user, apiError)
-	return user, resp, relevantError(err, *apiError)
+	return s.UserByUsernameWithContext(context.Background(), username, params)
+}
+
+// UserByUsernameWithContext is like UserByUsername but uses the given context for the request.
+func (s *UserService) UserByUsernameWithContext(ctx context.Context, username string, params *UserServiceParams) (*User, *http.Response, error) {
+	return s.user(ctx, s.sling.New().Get("by/username/").Get(username).QueryStruct(params), params)
 }
 
+// AuthenticatedUser returns the user the request is authorized for.
+// Requires a user auth context.
+// https://developer.twitter.com/en/docs/twitter-api/users/lookup/api-reference/get-users-me
 func (s *UserService) AuthenticatedUser(params *UserServiceParams) (*User, *http.Response, error) {
-	user := new(User)
+	return s.AuthenticatedUserWithContext(context.Background(), params)
+}
+
+// AuthenticatedUserWithContext is like AuthenticatedUser but uses the given context for the request.
+func (s *UserService) AuthenticatedUserWithContext(ctx context.Context, params *UserServiceParams) (*User, *http.Response, error) {
+	return s.user(ctx, s.sling.New().Get("me").QueryStruct(params), params)
+}
+
+// user requests a single user endpoint, validating the fields of the params
+// first. If the user could not be returned, an APIError describing why is
+// returned.
+func (s *UserService) user(ctx context.Context, req *sling.Sling, params *UserServiceParams) (*User, *http.Response, error) {
+	if params != nil {
+		if err := params.ValidateForUsers(); err != nil {
+			return new(User), nil, err
+		}
+	}
+	// Twitter API wraps the user response
+	wrap := &struct {
+		User   *User         `json:"data"`
+		Errors []ErrorDetail `json:"errors"`
+	}{}
+	apiError := new(APIError)
+	resp, err := receive(ctx, req, wrap, apiError)
+	err = relevantError(resp, err, *apiError)
+	if wrap.User == nil {
+		wrap.User = new(User)
+		if err == nil {
+			err = partialError(resp, wrap.Errors)
+		}
+	}
+	return wrap.User, resp, err
+}
+
+// UserLookup is the response of UserService.Lookup and
+// UserService.LookupByUsernames. Errors lists the requested users who could
+// not be returned (e.g. not found or suspended users).
+type UserLookup struct {
+	Users    []*User       `json:"data"`
+	Includes *Includes     `json:"includes"`
+	Errors   []ErrorDetail `json:"errors"`
+}
+
+// Lookup returns the users with the given ids, up to 100 at once. Use
+// BatchLookup for more.
+// https://developer.twitter.com/en/docs/twitter-api/users/lookup/api-reference/get-users
+func (s *UserService) Lookup(ids []UserID, params *UserServiceParams) (*UserLookup, *http.Response, error) {
+	return s.LookupWithContext(context.Background(), ids, params)
+}
+
+// LookupWithContext is like Lookup but uses the given context for the request.
+func (s *UserService) LookupWithContext(ctx context.Context, ids []UserID, params *UserServiceParams) (*UserLookup, *http.Response, error) {
+	query := &struct {
+		IDs []UserID `url:"ids,comma"`
+	}{ids}
+	return s.lookup(ctx, s.baseSling.New().Get("users").QueryStruct(query).QueryStruct(params), params)
+}
+
+// LookupByUsernames returns the users with the given usernames, up to 100 at
+// once. Use BatchLookupByUsernames for more.
+// https://developer.twitter.com/en/docs/twitter-api/users/lookup/api-reference/get-users-by
+func (s *UserService) LookupByUsernames(usernames []string, params *UserServiceParams) (*UserLookup, *http.Response, error) {
+	return s.LookupByUsernamesWithContext(context.Background(), usernames, params)
+}
+
+// LookupByUsernamesWithContext is like LookupByUsernames but uses the given context for the request.
+func (s *UserService) LookupByUsernamesWithContext(ctx context.Context, usernames []string, params *UserServiceParams) (*UserLookup, *http.Response, error) {
+	query := &struct {
+		Usernames []string `url:"usernames,comma"`
+	}{usernames}
+	return s.lookup(ctx, s.sling.New().Get("by").QueryStruct(query).QueryStruct(params), params)
+}
+
+// lookup requests a multiple user endpoint, validating the fields of the
+// params first.
+func (s *UserService) lookup(ctx context.Context, req *sling.Sling, params *UserServiceParams) (*UserLookup, *http.Response, error) {
+	lookup := new(UserLookup)
+	if params != nil {
+		if err := params.ValidateForUsers(); err != nil {
+			return lookup, nil, err
+		}
+	}
 	apiError := new(APIError)
-	resp, err := s.sling.New().Get("me").QueryStruct(params).Receive(user, apiError)
-	return user, resp, relevantError(err, *apiError)
+	resp, err := receive(ctx, req, lookup, apiError)
+	return lookup, resp, relevantError(resp, err, *apiError)
+}
+
+// UserBatch is the merged result of a UserService batch lookup, keyed by
+// UserID for BatchLookup or by lowercase username for
+// BatchLookupByUsernames.
+type UserBatch[K comparable] struct {
+	// Users maps the keys of the users found to the users.
+	Users map[K]*User
+	// Errors lists the requested users who could not be returned (e.g. not
+	// found or suspended users).
+	Errors []ErrorDetail
+}
+
+// BatchLookup returns the users with the given ids, looking them up 100 at a
+// time with a few requests in flight at once. If a request fails, the error
+// is returned along with the users found so far.
+func (s *UserService) BatchLookup(ids []UserID, params *UserServiceParams) (*UserBatch[UserID], error) {
+	return s.BatchLookupWithContext(context.Background(), ids, params)
+}
+
+// BatchLookupWithContext is like BatchLookup but uses the given context for the requests.
+func (s *UserService) BatchLookupWithContext(ctx context.Context, ids []UserID, params *UserServiceParams) (*UserBatch[UserID], error) {
+	return batchLookup(ctx, ids, params, s.LookupWithContext, func(id UserID) UserID {
+		return id
+	}, func(user *User) UserID {
+		return user.ID
+	})
+}
+
+// BatchLookupByUsernames returns the users with the given usernames, looking
+// them up 100 at a time with a few requests in flight at once. If a request
+// fails, the error is returned along with the users found so far.
+func (s *UserService) BatchLookupByUsernames(usernames []string, params *UserServiceParams) (*UserBatch[string], error) {
+	return s.BatchLookupByUsernamesWithContext(context.Background(), usernames, params)
+}
+
+// BatchLookupByUsernamesWithContext is like BatchLookupByUsernames but uses the given context for the requests.
+func (s *UserService) BatchLookupByUsernamesWithContext(ctx context.Context, usernames []string, params *UserServiceParams) (*UserBatch[string], error) {
+	// usernames are case insensitive
+	return batchLookup(ctx, usernames, params, s.LookupByUsernamesWithContext, strings.ToLower, func(user *User) string {
+		return strings.ToLower(user.Username)
+	})
+}
+
+const (
+	// maxUserLookup is the most users a lookup request may ask for.
+	maxUserLookup = 100
+	// userBatchConcurrency is the most lookup requests a batch lookup makes
+	// at once.
+	userBatchConcurrency = 4
+)
+
+// batchLookup looks up the users with the given keys (ids or usernames) in
+// chunks with bounded concurrency, and merges the results by the key of
+// each user. Keys which normalize the same are only requested once.
+func batchLookup[K comparable](ctx context.Context, keys []K, params *UserServiceParams, lookup func(context.Context, []K, *UserServiceParams) (*UserLookup, *http.Response, error), normalize func(K) K, key func(*User) K) (*UserBatch[K], error) {
+	// drop duplicate keys so no request is wasted
+	seen := make(map[K]bool)
+	var unique []K
+	for _, k := range keys {
+		if !seen[normalize(k)] {
+			seen[normalize(k)] = true
+			unique = append(unique, k)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	batch := &UserBatch[K]{Users: make(map[K]*User)}
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		firstErr error
+	)
+	sem := make(chan struct{}, userBatchConcurrency)
+	for start := 0; start < len(unique); start += maxUserLookup {
+		end := start + maxUserLookup
+		if end > len(unique) {
+			end = len(unique)
+		}
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		wg.Add(1)
+		go func(chunk []K) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			result, _, err := lookup(ctx, chunk, params)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+					// stop requesting further chunks
+					cancel()
+				}
+				return
+			}
+			for _, user := range result.Users {
+				batch.Users[key(user)] = user
+			}
+			batch.Errors = append(batch.Errors, result.Errors...)
+		}(unique[start:end])
+	}
+	wg.Wait()
+	if firstErr == nil {
+		// the caller's context was done before all chunks were requested
+		firstErr = ctx.Err()
+	}
+	return batch, firstErr
 }
@@ -0,0 +1,152 @@
+package twitter
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// userLookupHandler responds to a users lookup with a user for each
+// requested id, except id 0, which is reported not found.
+func userLookupHandler(w http.ResponseWriter, r *http.Request) {
+	var lookup struct {
+		Users  []*User       `json:"data"`
+		Errors []ErrorDetail `json:"errors"`
+	}
+	for _, id := range strings.Split(r.URL.Query().Get("ids"), ",") {
+		if id == "0" {
+			lookup.Errors = append(lookup.Errors, ErrorDetail{Title: "Not Found Error", ResourceID: id})
+			continue
+		}
+		userID, _ := strconv.ParseInt(id, 10, 64)
+		lookup.Users = append(lookup.Users, &User{ID: UserID(userID), Username: "user" + id})
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(lookup)
+}
+
+func TestUserService_BatchLookup(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	var (
+		mu     sync.Mutex
+		chunks []int
+	)
+	mux.HandleFunc("/2/users", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assert.Equal(t, "username", r.URL.Query().Get("user.fields"))
+		mu.Lock()
+		chunks = append(chunks, len(strings.Split(r.URL.Query().Get("ids"), ",")))
+		mu.Unlock()
+		userLookupHandler(w, r)
+	})
+
+	var ids []UserID
+	for i := 0; i < 250; i++ {
+		ids = append(ids, UserID(i))
+	}
+	// duplicates are only requested once
+	ids = append(ids, 1, 2, 3)
+
+	client := NewClient(httpClient)
+	params := &UserServiceParams{FieldSet{UserFields: []UserField{UserFieldUsername}}}
+	batch, err := client.Users.BatchLookup(ids, params)
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []int{100, 100, 50}, chunks)
+	assert.Len(t, batch.Users, 249)
+	assert.Equal(t, &User{ID: 249, Username: "user249"}, batch.Users[249])
+	assert.Equal(t, []ErrorDetail{{Title: "Not Found Error", ResourceID: "0"}}, batch.Errors)
+}
+
+func TestUserService_BatchLookupConcurrency(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	var (
+		mu             sync.Mutex
+		inFlight, most int
+	)
+	mux.HandleFunc("/2/users", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		inFlight++
+		if inFlight > most {
+			most = inFlight
+		}
+		mu.Unlock()
+		time.Sleep(20 * time.Millisecond)
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+		userLookupHandler(w, r)
+	})
+
+	var ids []UserID
+	for i := 1; i <= 1000; i++ {
+		ids = append(ids, UserID(i))
+	}
+	client := NewClient(httpClient)
+	batch, err := client.Users.BatchLookup(ids, nil)
+	assert.Nil(t, err)
+	assert.Len(t, batch.Users, 1000)
+	assert.True(t, most > 1, "lookups were not concurrent")
+	assert.True(t, most <= userBatchConcurrency, "%d lookups were in flight", most)
+}
+
+func TestUserService_BatchLookupError(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/2/users", func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Query().Get("ids"), "101,") {
+			// fail after the first chunk is found
+			time.Sleep(50 * time.Millisecond)
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			fmt.Fprintf(w, `{"title": "Service Unavailable"}`)
+			return
+		}
+		userLookupHandler(w, r)
+	})
+
+	var ids []UserID
+	for i := 1; i <= 200; i++ {
+		ids = append(ids, UserID(i))
+	}
+	client := NewClient(httpClient)
+	batch, err := client.Users.BatchLookup(ids, nil)
+	if assert.IsType(t, APIError{}, err) {
+		assert.Equal(t, http.StatusServiceUnavailable, err.(APIError).StatusCode)
+	}
+	// the users found by the other requests are returned
+	assert.Len(t, batch.Users, 100)
+	assert.NotNil(t, batch.Users[1])
+}
+
+func TestUserService_BatchLookupByUsernames(t *testing.T) {
+	httpClient, mux, server := testServer()
+	defer server.Close()
+
+	mux.HandleFunc("/2/users/by", func(w http.ResponseWriter, r *http.Request) {
+		assertMethod(t, "GET", r)
+		assertQuery(t, map[string]string{"usernames": "Golang,gophers"}, r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"data": [{"id": "1", "username": "golang"}, {"id": "2", "username": "Gophers"}]}`)
+	})
+
+	client := NewClient(httpClient)
+	batch, err := client.Users.BatchLookupByUsernames([]string{"Golang", "gophers", "golang"}, nil)
+	assert.Nil(t, err)
+	// usernames are case insensitive, so the users are keyed in lowercase
+	assert.Equal(t, map[string]*User{
+		"golang":  {ID: 1, Username: "golang"},
+		"gophers": {ID: 2, Username: "Gophers"},
+	}, batch.Users)
+}